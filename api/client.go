@@ -2,9 +2,12 @@ package api
 
 import (
 	"context"
+	"time"
 
+	"github.com/dlshle/authnz/pkg/tlsutil"
 	pb "github.com/dlshle/authnz/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type client struct {
@@ -13,7 +16,42 @@ type client struct {
 }
 
 func NewAuthNZClient(endopint string) (*client, error) {
-	conn, c, err := connect(endopint)
+	conn, c, err := connect(endopint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return &client{conn: conn, grpcClient: c}, nil
+}
+
+// NewAuthNZClientWithToken attaches the bearer token to every call, required when the server has auth enabled
+func NewAuthNZClientWithToken(endpoint, token string) (*client, error) {
+	conn, c, err := connect(endpoint, grpc.WithInsecure(), grpc.WithPerRPCCredentials(bearerToken(token)))
+	if err != nil {
+		return nil, err
+	}
+	return &client{conn: conn, grpcClient: c}, nil
+}
+
+type TLSConfig struct {
+	// system roots are used when empty
+	CAFile string
+	// optional client key pair for mutual tls, rotated files are picked up without reconnecting
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
+// NewAuthNZClientWithTLS dials over tls, token is optional when the client certificate identifies the caller
+func NewAuthNZClientWithTLS(endpoint string, tlsConfig TLSConfig, token string) (*client, error) {
+	cfg, err := tlsutil.NewClientConfig(tlsConfig.CAFile, tlsConfig.CertFile, tlsConfig.KeyFile, tlsConfig.ServerName)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(cfg))}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	}
+	conn, c, err := connect(endpoint, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +65,45 @@ func (c *client) Authorize(ctx context.Context, subjectID, policyID string) (ver
 	return
 }
 
+func (c *client) AuthorizeWithMFASession(ctx context.Context, subjectID, policyID, mfaSessionID string) (pb.Verdict, error) {
+	resp, err := c.grpcClient.Authorize(ctx, &pb.AuthorizeRequest{SubjectId: subjectID, PolicyId: policyID, MfaSessionId: mfaSessionID})
+	if err != nil {
+		return pb.Verdict_UNKNOWN, err
+	}
+	return resp.Verdict, nil
+}
+
+// AuthorizeResource checks the policies targeting action on resources of the resource's type
+func (c *client) AuthorizeResource(ctx context.Context, subjectID, action string, resource *pb.Resource) (pb.Verdict, error) {
+	resp, err := c.grpcClient.Authorize(ctx, &pb.AuthorizeRequest{SubjectId: subjectID, Action: action, Resource: resource})
+	if err != nil {
+		return pb.Verdict_UNKNOWN, err
+	}
+	return resp.Verdict, nil
+}
+
+// CheckPermission permits when one of the subject's roles grants permission
+func (c *client) CheckPermission(ctx context.Context, subjectID, permission string) (pb.Verdict, error) {
+	resp, err := c.grpcClient.CheckPermission(ctx, &pb.CheckPermissionRequest{SubjectId: subjectID, Permission: permission})
+	if err != nil {
+		return pb.Verdict_UNKNOWN, err
+	}
+	return resp.Verdict, nil
+}
+
+// CheckRelation permits when the subject has relation on namespace:objectID
+func (c *client) CheckRelation(ctx context.Context, namespace, objectID, relation, subjectID string) (pb.Verdict, error) {
+	resp, err := c.grpcClient.CheckRelation(ctx, &pb.CheckRelationRequest{
+		Object:    &pb.RelationObject{Namespace: namespace, Id: objectID},
+		Relation:  relation,
+		SubjectId: subjectID,
+	})
+	if err != nil {
+		return pb.Verdict_UNKNOWN, err
+	}
+	return resp.Verdict, nil
+}
+
 func (c *client) AddSubject(ctx context.Context, userID string) (*pb.Subject, error) {
 	resp, err := c.grpcClient.AddSubject(ctx, &pb.AddSubjectRequest{UserId: userID})
 	if err != nil {
@@ -35,6 +112,11 @@ func (c *client) AddSubject(ctx context.Context, userID string) (*pb.Subject, er
 	return resp.Subject, nil
 }
 
+func (c *client) SetPassword(ctx context.Context, subjectID, password string) error {
+	_, err := c.grpcClient.SetPassword(ctx, &pb.SetPasswordRequest{SubjectId: subjectID, Password: password})
+	return err
+}
+
 func (c *client) FindSubjectsByUserID(ctx context.Context, userID string) ([]*pb.Subject, error) {
 	resp, err := c.grpcClient.FindSubjectsByUserID(ctx, &pb.SubjectsByUserIDRequest{UserId: userID})
 	if err != nil {
@@ -59,6 +141,56 @@ func (c *client) CreateContract(ctx context.Context, subjectID, groupID string)
 	return resp.Contract, nil
 }
 
+// AddTemporaryContract adds the subject to the group until expiresAt
+func (c *client) AddTemporaryContract(ctx context.Context, subjectID, groupID string, expiresAt time.Time) (*pb.Contract, error) {
+	resp, err := c.grpcClient.CreateContract(ctx, &pb.ContractRequest{Contract: &pb.Contract{SubjectId: subjectID, GroupId: groupID, ExpiresAt: expiresAt.Unix()}})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Contract, nil
+}
+
+// ExtendContract moves the end of a temporary contract to expiresAt, which may also shorten it
+func (c *client) ExtendContract(ctx context.Context, contract *pb.Contract, expiresAt time.Time) (*pb.Contract, error) {
+	resp, err := c.grpcClient.UpdateContractWindow(ctx, &pb.ContractWindowRequest{ContractId: contract.Id, NotBefore: contract.NotBefore, ExpiresAt: expiresAt.Unix()})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Contract, nil
+}
+
+// RequestAccess asks for membership in the group for duration, a zero duration asks for a permanent one
+func (c *client) RequestAccess(ctx context.Context, subjectID, groupID, reason string, duration time.Duration) (*pb.AccessRequest, error) {
+	return c.grpcClient.RequestAccess(ctx, &pb.RequestAccessRequest{SubjectId: subjectID, GroupId: groupID, Reason: reason, DurationSeconds: int64(duration / time.Second)})
+}
+
+// ListPendingAccessRequests returns the requests approverID may decide, with auth enabled approverID may be
+// left empty to list the caller's own
+func (c *client) ListPendingAccessRequests(ctx context.Context, approverID string) ([]*pb.AccessRequest, error) {
+	resp, err := c.grpcClient.ListPendingAccessRequests(ctx, &pb.ListPendingAccessRequestsRequest{ApproverId: approverID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Requests, nil
+}
+
+func (c *client) ApproveAccessRequest(ctx context.Context, requestID, approverID, reason string) (*pb.AccessRequest, error) {
+	return c.grpcClient.ApproveAccessRequest(ctx, &pb.AccessDecisionRequest{RequestId: requestID, ApproverId: approverID, Reason: reason})
+}
+
+func (c *client) DenyAccessRequest(ctx context.Context, requestID, approverID, reason string) (*pb.AccessRequest, error) {
+	return c.grpcClient.DenyAccessRequest(ctx, &pb.AccessDecisionRequest{RequestId: requestID, ApproverId: approverID, Reason: reason})
+}
+
+// BreakGlass grants the subject the group at once for duration, a zero duration grants the longest the server allows
+func (c *client) BreakGlass(ctx context.Context, subjectID, groupID, justification string, duration time.Duration) (*pb.Contract, error) {
+	resp, err := c.grpcClient.BreakGlass(ctx, &pb.BreakGlassRequest{SubjectId: subjectID, GroupId: groupID, Justification: justification, DurationSeconds: int64(duration / time.Second)})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Contract, nil
+}
+
 func (c *client) CreateGroupForSubjects(ctx context.Context, subjectIDs []string, attributes map[string]string) (*pb.CreateGroupForSubjectsResponse, error) {
 	var pbAttributes []*pb.Attribute
 	for k, v := range attributes {
@@ -75,13 +207,143 @@ func (c *client) GetGroupsBySubjectID(ctx context.Context, subjectID string) ([]
 	return resp.Groups, nil
 }
 
+// ListGroupMembers returns a page of the group's contracts and the token of the next page, empty on the last one
+func (c *client) ListGroupMembers(ctx context.Context, groupID string, pageSize int, pageToken string) ([]*pb.Contract, string, error) {
+	resp, err := c.grpcClient.ListGroupMembers(ctx, &pb.ListGroupMembersRequest{GroupId: groupID, PageSize: int32(pageSize), PageToken: pageToken})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Members, resp.NextPageToken, nil
+}
+
+// AddSubjectsToGroup adds the subjects to the group permanently, check the code of each result for the
+// subjects that were not added
+func (c *client) AddSubjectsToGroup(ctx context.Context, groupID string, subjectIDs []string) ([]*pb.SubjectMembershipResult, error) {
+	resp, err := c.grpcClient.AddSubjectsToGroup(ctx, &pb.AddSubjectsToGroupRequest{GroupId: groupID, SubjectIds: subjectIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *client) RemoveSubjectsFromGroup(ctx context.Context, groupID string, subjectIDs []string) ([]*pb.SubjectMembershipResult, error) {
+	resp, err := c.grpcClient.RemoveSubjectsFromGroup(ctx, &pb.RemoveSubjectsFromGroupRequest{GroupId: groupID, SubjectIds: subjectIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *client) ListGroupsByAttributes(ctx context.Context, attributes map[string]string) ([]*pb.Group, error) {
+	var pbAttributes []*pb.Attribute
+	for k, v := range attributes {
+		pbAttributes = append(pbAttributes, &pb.Attribute{Key: k, Value: v})
+	}
+	resp, err := c.grpcClient.ListGroupsByAttributes(ctx, &pb.ListGroupsByAttributesRequest{Attributes: pbAttributes})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Groups, nil
+}
+
+func (c *client) AttachParentGroup(ctx context.Context, groupID, parentID string) (*pb.Group, error) {
+	resp, err := c.grpcClient.AttachParentGroup(ctx, &pb.GroupParentRequest{GroupId: groupID, ParentId: parentID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Group, nil
+}
+
+func (c *client) DetachParentGroup(ctx context.Context, groupID, parentID string) (*pb.Group, error) {
+	resp, err := c.grpcClient.DetachParentGroup(ctx, &pb.GroupParentRequest{GroupId: groupID, ParentId: parentID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Group, nil
+}
+
+// GetEffectiveGroup returns the group with the attributes it inherits from its parents
+func (c *client) GetEffectiveGroup(ctx context.Context, groupID string) (*pb.Group, error) {
+	resp, err := c.grpcClient.GetEffectiveGroup(ctx, &pb.GroupByIDRequest{GroupId: groupID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Group, nil
+}
+
+func (c *client) CreateRole(ctx context.Context, name string, permissions ...string) (*pb.Role, error) {
+	return c.grpcClient.CreateRole(ctx, &pb.RoleRequest{Role: &pb.Role{Name: name, Permissions: permissions}})
+}
+
+func (c *client) AssignGroupRole(ctx context.Context, groupID, roleID string) (*pb.Group, error) {
+	resp, err := c.grpcClient.AssignGroupRole(ctx, &pb.GroupRoleRequest{GroupId: groupID, RoleId: roleID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Group, nil
+}
+
+func (c *client) UnassignGroupRole(ctx context.Context, groupID, roleID string) (*pb.Group, error) {
+	resp, err := c.grpcClient.UnassignGroupRole(ctx, &pb.GroupRoleRequest{GroupId: groupID, RoleId: roleID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Group, nil
+}
+
+// CreateSoDRule keeps subjects out of more than one of the groups, a dynamic rule only while the contracts are in effect
+func (c *client) CreateSoDRule(ctx context.Context, name string, kind pb.SoDRule_Kind, groupIDs ...string) (*pb.SoDRule, error) {
+	groups := make([]*pb.SoDGroup, len(groupIDs), len(groupIDs))
+	for i, groupID := range groupIDs {
+		groups[i] = &pb.SoDGroup{GroupId: groupID}
+	}
+	return c.grpcClient.CreateSoDRule(ctx, &pb.SoDRuleRequest{Rule: &pb.SoDRule{Name: name, Kind: kind, Groups: groups}})
+}
+
+func (c *client) WriteRelationTuples(ctx context.Context, writes, deletes []*pb.RelationTuple) error {
+	_, err := c.grpcClient.WriteRelationTuples(ctx, &pb.WriteRelationTuplesRequest{Writes: writes, Deletes: deletes})
+	return err
+}
+
+// EnrollTOTP needs mfaSessionID only to replace a confirmed enrollment
+func (c *client) EnrollTOTP(ctx context.Context, subjectID, accountName, mfaSessionID string) (*pb.EnrollTOTPResponse, error) {
+	return c.grpcClient.EnrollTOTP(ctx, &pb.EnrollTOTPRequest{SubjectId: subjectID, AccountName: accountName, MfaSessionId: mfaSessionID})
+}
+
+func (c *client) ConfirmTOTP(ctx context.Context, subjectID, code string) error {
+	_, err := c.grpcClient.ConfirmTOTP(ctx, &pb.VerifyTOTPRequest{SubjectId: subjectID, Code: code})
+	return err
+}
+
+func (c *client) VerifyTOTP(ctx context.Context, subjectID, code string) (*pb.MFASessionResponse, error) {
+	return c.grpcClient.VerifyTOTP(ctx, &pb.VerifyTOTPRequest{SubjectId: subjectID, Code: code})
+}
+
+func (c *client) RedeemRecoveryCode(ctx context.Context, subjectID, recoveryCode string) (*pb.MFASessionResponse, error) {
+	return c.grpcClient.RedeemRecoveryCode(ctx, &pb.RecoveryCodeRequest{SubjectId: subjectID, RecoveryCode: recoveryCode})
+}
+
+func (c *client) FederatedLogin(ctx context.Context, idToken string) (*pb.FederatedLoginResponse, error) {
+	return c.grpcClient.FederatedLogin(ctx, &pb.FederatedLoginRequest{IdToken: idToken})
+}
+
 func (c *client) Close() error {
 	return c.conn.Close()
 }
 
-func connect(endpoint string) (*grpc.ClientConn, pb.AuthNZClient, error) {
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+func connect(endpoint string, opts ...grpc.DialOption) (*grpc.ClientConn, pb.AuthNZClient, error) {
 	// conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	conn, err := grpc.Dial(endpoint, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
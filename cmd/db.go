@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dlshle/authnz/internal/config"
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	defaultConnectRetries = 5
+	maxConnectBackoff     = 30 * time.Second
+	redactedPassword      = "REDACTED"
+
+	defaultMaxReplicaLag        = 5 * time.Second
+	defaultReadYourWritesWindow = 10 * time.Second
+	replicaLagCheckInterval     = time.Second
+)
+
+// openDB opens the sql database with the configured pool and waits until it answers a ping
+func openDB(dbConfig config.DatabaseConfig) (*sqlx.DB, error) {
+	var (
+		db  *sqlx.DB
+		err error
+	)
+	if dbConfig.Driver == "sqlite" {
+		logging.GlobalLogger.Infof(context.Background(), "sqlite database file: %s", dbConfig.Path)
+		db, err = sqlx.Open(store.SQLiteDriverName, store.SQLiteDSN(dbConfig.Path))
+	} else {
+		var password string
+		if password, err = readDBPassword(dbConfig); err != nil {
+			return nil, err
+		}
+		logging.GlobalLogger.Infof(context.Background(), "db connection info: %s", postgresDSN(dbConfig, redactedPassword))
+		db, err = sqlx.Open("postgres", postgresDSN(dbConfig, password))
+	}
+	if err != nil {
+		return nil, err
+	}
+	configurePool(db, dbConfig)
+	if err = pingDB(db, dbConfig); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// openReplicas opens the read replicas without waiting for them, an unreachable replica is skipped until it recovers
+func openReplicas(primary *sqlx.DB, dbConfig config.DatabaseConfig) (*store.ReplicaSet, error) {
+	replicas := make([]*sqlx.DB, 0, len(dbConfig.ReadReplicas))
+	for _, dsn := range dbConfig.ReadReplicas {
+		db, err := sqlx.Open("postgres", dsn)
+		if err != nil {
+			for _, replica := range replicas {
+				replica.Close()
+			}
+			return nil, err
+		}
+		configurePool(db, dbConfig)
+		replicas = append(replicas, db)
+	}
+	maxLag := time.Duration(dbConfig.MaxReplicaLagSeconds) * time.Second
+	if maxLag == 0 {
+		maxLag = defaultMaxReplicaLag
+	}
+	sessionWindow := time.Duration(dbConfig.ReadYourWritesSeconds) * time.Second
+	if sessionWindow == 0 {
+		sessionWindow = defaultReadYourWritesWindow
+	}
+	logging.GlobalLogger.Infof(context.Background(), "%d read replicas, max lag %s, read-your-writes window %s", len(replicas), maxLag, sessionWindow)
+	replicaSet := store.NewReplicaSet(primary, replicas, maxLag, sessionWindow)
+	go replicaSet.MonitorLag(context.Background(), replicaLagCheckInterval)
+	return replicaSet, nil
+}
+
+func configurePool(db *sqlx.DB, dbConfig config.DatabaseConfig) {
+	db.SetMaxOpenConns(dbConfig.MaxOpenConns)
+	if dbConfig.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(dbConfig.MaxIdleConns)
+	}
+	db.SetConnMaxLifetime(time.Duration(dbConfig.ConnMaxLifetimeSeconds) * time.Second)
+	db.SetConnMaxIdleTime(time.Duration(dbConfig.ConnMaxIdleTimeSeconds) * time.Second)
+}
+
+// readDBPassword prefers pass_file over pass_env over pass
+func readDBPassword(dbConfig config.DatabaseConfig) (string, error) {
+	if dbConfig.PassFile != "" {
+		password, err := os.ReadFile(dbConfig.PassFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(password), "\r\n"), nil
+	}
+	if dbConfig.PassEnv != "" {
+		password, ok := os.LookupEnv(dbConfig.PassEnv)
+		if !ok {
+			return "", errors.Error("database password environment variable " + dbConfig.PassEnv + " is not set")
+		}
+		return password, nil
+	}
+	return dbConfig.Pass, nil
+}
+
+func postgresDSN(dbConfig config.DatabaseConfig, password string) string {
+	sslMode := dbConfig.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	params := [][2]string{
+		{"host", dbConfig.Host},
+		{"port", strconv.Itoa(dbConfig.Port)},
+		{"user", dbConfig.User},
+		{"password", password},
+		{"dbname", dbConfig.DBName},
+		{"sslmode", sslMode},
+		{"sslcert", dbConfig.SSLCert},
+		{"sslkey", dbConfig.SSLKey},
+		{"sslrootcert", dbConfig.SSLRootCert},
+	}
+	if dbConfig.ConnectTimeoutSeconds > 0 {
+		params = append(params, [2]string{"connect_timeout", strconv.Itoa(dbConfig.ConnectTimeoutSeconds)})
+	}
+	var dsnBuilder strings.Builder
+	for _, param := range params {
+		if param[1] == "" {
+			continue
+		}
+		if dsnBuilder.Len() > 0 {
+			dsnBuilder.WriteRune(' ')
+		}
+		dsnBuilder.WriteString(param[0])
+		dsnBuilder.WriteRune('=')
+		dsnBuilder.WriteString(quoteDSNValue(param[1]))
+	}
+	return dsnBuilder.String()
+}
+
+// quoteDSNValue quotes values the way libpq expects, so passwords with spaces or quotes survive
+func quoteDSNValue(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// pingDB retries the first connection with exponential backoff, the database may start after authnz
+func pingDB(db *sqlx.DB, dbConfig config.DatabaseConfig) error {
+	retries := dbConfig.ConnectRetries
+	if retries == 0 {
+		retries = defaultConnectRetries
+	} else if retries < 0 {
+		retries = 0
+	}
+	timeout := time.Duration(dbConfig.ConnectTimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := db.PingContext(ctx)
+		cancel()
+		if err == nil || attempt >= retries {
+			return err
+		}
+		logging.GlobalLogger.Warnf(context.Background(), "database is not reachable (%s), retrying in %s", err.Error(), backoff)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+}
@@ -4,17 +4,27 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/dlshle/authnz/internal/access"
+	"github.com/dlshle/authnz/internal/auth"
 	"github.com/dlshle/authnz/internal/config"
 	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/federation"
 	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/mfa"
 	"github.com/dlshle/authnz/internal/migration"
+	"github.com/dlshle/authnz/internal/oidc"
 	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/relation"
+	"github.com/dlshle/authnz/internal/role"
 	"github.com/dlshle/authnz/internal/server"
+	"github.com/dlshle/authnz/internal/sod"
 	"github.com/dlshle/authnz/internal/subject"
 	pb "github.com/dlshle/authnz/proto"
-	"github.com/dlshle/gommon/logging"
 	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -22,47 +32,158 @@ func main() {
 		configPath string
 	)
 	flag.StringVar(&configPath, "config", "./etc/config.yaml", "path to the config file")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-config path] [migrate status|up|down [steps]|to <version>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 	cfg, err := config.Load(configPath)
 	if err != nil {
 		panic(err)
 	}
-	grpcServer, err := initGrpcServer(cfg)
+	if flag.Arg(0) == "migrate" {
+		if err = runMigrate(cfg.Database, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+	grpcServer, oidcProvider, interceptors, err := initGrpcServer(cfg)
 	if err != nil {
 		panic(err)
 	}
-	err = server.StartServer(cfg.Server, grpcServer)
+	if oidcProvider != nil {
+		go oidcProvider.PurgeExpiredCodes(context.Background())
+		go func() {
+			if err := server.StartHTTPServer(cfg.OIDC.HTTP, cfg.OIDC.TLS, oidcProvider.Routes()); err != nil {
+				panic(err)
+			}
+		}()
+	}
+	err = server.StartServer(cfg.Server, grpcServer, interceptors...)
 	if err != nil {
 		panic(err)
 	}
 }
 
-func initGrpcServer(config config.Config) (pb.AuthNZServer, error) {
-	dbConfig := config.Database
-	db_connect := fmt.Sprintf("host=%s port=%d user=%s "+
-		"password=%s dbname=%s sslmode=disable",
-		dbConfig.Host, dbConfig.Port, dbConfig.User, dbConfig.Pass, dbConfig.DBName)
-	logging.GlobalLogger.Infof(context.Background(), "db connection info: %s", db_connect)
-	db, err := sqlx.Open("postgres", db_connect)
+func initGrpcServer(config config.Config) (pb.AuthNZServer, *oidc.Provider, []grpc.UnaryServerInterceptor, error) {
+	stores, err := initStores(config.Database)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	groupHandler := group.NewHandler(stores.group, stores.contract)
+	policyHandler := policy.NewHandler(stores.policy)
+	roleHandler := role.NewHandler(stores.role)
+	namespaces, err := relation.NewNamespaces(config.Relations.Namespaces)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	relationHandler := relation.NewHandler(stores.relation, namespaces)
+	sodStore := sod.NewCachedStore(stores.sod)
+	sodHandler := sod.NewHandler(sodStore)
+	sodGuard := sod.NewGuard(sodStore, stores.group, stores.contract)
+	subjectHandler := subject.NewHandler(stores.subject, stores.contract, stores.group, sodGuard)
+	contractHandler := contract.NewHandler(stores.contract, sodGuard, breakGlassMaxDuration(config.Contracts))
+	if config.Contracts.ReapIntervalSeconds >= 0 {
+		go contract.NewReaper(stores.contract, contractReapInterval(config.Contracts)).Run(context.Background())
+	}
+	mfaHandler := mfa.NewHandler(stores.mfa, stores.subject, config.MFA.Issuer, time.Duration(config.MFA.SessionTTLSeconds)*time.Second)
+
+	federationHandler := federation.NewHandler(stores.federation, federatedIssuers(config.Federation), subjectHandler)
+
+	authorizer := server.NewAuthorizer(contractHandler, groupHandler, policyHandler, roleHandler, sodGuard)
+	accessHandler := access.NewHandler(stores.access, stores.group, stores.contract, sodGuard, authorizer)
+	grpcServer := server.NewGRPCServer(subjectHandler, groupHandler, policyHandler, roleHandler, relationHandler, contractHandler, accessHandler, sodHandler, mfaHandler, federationHandler, authorizer)
+
+	var oidcProvider *oidc.Provider
+	if config.OIDC.HTTP != "" {
+		oidcProvider, err = initOIDCProvider(config.OIDC, stores.oidc, subjectHandler, mfaHandler, contractHandler, groupHandler)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	var interceptors []grpc.UnaryServerInterceptor
+	if config.Auth.Enabled {
+		interceptors = append(interceptors, initAuthInterceptor(config.Auth, config.Server.TLS, authorizer, oidcProvider).Unary)
+	}
+	if stores.replicas != nil {
+		interceptors = append(interceptors, server.NewSessionInterceptor(stores.replicas))
 	}
-	err = execMigrationScript(db)
+	return grpcServer, oidcProvider, interceptors, nil
+}
+
+func initAuthInterceptor(authConfig config.AuthConfig, tlsConfig config.TLSConfig, authorizer *server.Authorizer, oidcProvider *oidc.Provider) *auth.Interceptor {
+	bootstrap := auth.BootstrapAdmin{
+		SubjectID:    authConfig.BootstrapAdmin.SubjectID,
+		Token:        authConfig.BootstrapAdmin.Token,
+		ClientCertCN: authConfig.BootstrapAdmin.ClientCertCN,
+	}
+	var tokenVerifier auth.TokenVerifier
+	if oidcProvider != nil {
+		tokenVerifier = oidcProvider
+	}
+	return auth.NewInterceptor(authorizer, auth.Options{
+		DefaultPolicyID: authConfig.DefaultPolicyID,
+		MethodPolicies:  authConfig.MethodPolicies,
+	}, auth.NewBearerAuthenticator(bootstrap, tokenVerifier), auth.NewClientCertAuthenticator(bootstrap, auth.CertSubjectMapping{
+		Source: tlsConfig.ClientSubjectSource,
+		Prefix: tlsConfig.ClientSubjectPrefix,
+	}))
+}
+
+func initOIDCProvider(oidcConfig config.OIDCConfig, oidcStore oidc.Store, subjectHandler *subject.Handler, mfaHandler *mfa.Handler, contractHandler *contract.Handler, groupHandler *group.Handler) (*oidc.Provider, error) {
+	signingKey, err := oidc.LoadSigningKey(oidcConfig.SigningKeyFile)
 	if err != nil {
 		return nil, err
 	}
-	groupSQLStore := group.NewSQLStore(db)
-	policySQLStore := policy.NewSQLStore(db)
-	subjectSQLStore := subject.NewSQLStore(db)
-	contractSQLStore := contract.NewContractStore(db)
+	clients := make([]oidc.Client, len(oidcConfig.Clients), len(oidcConfig.Clients))
+	for i, client := range oidcConfig.Clients {
+		clients[i] = oidc.Client{ID: client.ID, Secret: client.Secret, RedirectURIs: client.RedirectURIs}
+	}
+	return oidc.NewProvider(oidcStore, signingKey, oidc.Options{
+		Issuer:   oidcConfig.Issuer,
+		Clients:  clients,
+		CodeTTL:  time.Duration(oidcConfig.CodeTTLSeconds) * time.Second,
+		TokenTTL: time.Duration(oidcConfig.TokenTTLSeconds) * time.Second,
+	}, subjectHandler, mfaHandler, contractHandler, groupHandler), nil
+}
 
-	groupHandler := group.NewHandler(groupSQLStore, contractSQLStore)
-	policyHandler := policy.NewHandler(policySQLStore)
-	subjectHandler := subject.NewHandler(subjectSQLStore, contractSQLStore, groupSQLStore)
-	contractHandler := contract.NewHandler(contractSQLStore)
+func contractReapInterval(contractsConfig config.ContractsConfig) time.Duration {
+	if contractsConfig.ReapIntervalSeconds == 0 {
+		return time.Minute
+	}
+	return time.Duration(contractsConfig.ReapIntervalSeconds) * time.Second
+}
 
-	grpcServer := server.NewGRPCServer(subjectHandler, groupHandler, policyHandler, contractHandler)
-	return grpcServer, nil
+func breakGlassMaxDuration(contractsConfig config.ContractsConfig) time.Duration {
+	if contractsConfig.BreakGlassMaxDurationSeconds <= 0 {
+		return time.Hour
+	}
+	return time.Duration(contractsConfig.BreakGlassMaxDurationSeconds) * time.Second
+}
+
+func federatedIssuers(federationConfig config.FederationConfig) []federation.Issuer {
+	issuers := make([]federation.Issuer, len(federationConfig.Issuers), len(federationConfig.Issuers))
+	for i, issuerConfig := range federationConfig.Issuers {
+		keys := federation.NewURLKeySource(issuerConfig.JWKSURL)
+		if issuerConfig.JWKSFile != "" {
+			keys = federation.NewFileKeySource(issuerConfig.JWKSFile)
+		}
+		mappings := make([]federation.ClaimMapping, len(issuerConfig.ClaimMappings), len(issuerConfig.ClaimMappings))
+		for j, mapping := range issuerConfig.ClaimMappings {
+			mappings[j] = federation.ClaimMapping{Claim: mapping.Claim, Attribute: mapping.Attribute, Default: mapping.Default}
+		}
+		issuers[i] = federation.Issuer{
+			Issuer:        issuerConfig.Issuer,
+			Audience:      issuerConfig.Audience,
+			Keys:          keys,
+			AutoProvision: issuerConfig.AutoProvision,
+			UserIDClaim:   issuerConfig.UserIDClaim,
+			ClaimMappings: mappings,
+		}
+	}
+	return issuers
 }
 
 func execMigrationScript(db *sqlx.DB) error {
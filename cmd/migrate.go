@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dlshle/authnz/internal/config"
+	"github.com/dlshle/authnz/internal/migration"
+	"github.com/dlshle/gommon/errors"
+)
+
+func runMigrate(dbConfig config.DatabaseConfig, args []string) error {
+	if dbConfig.Driver == "memory" || dbConfig.Driver == "kv" {
+		return errors.Error("the " + dbConfig.Driver + " driver has no schema to migrate")
+	}
+	db, err := openDB(dbConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator, err := migration.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	command := "status"
+	if len(args) > 0 {
+		command = args[0]
+	}
+	switch command {
+	case "status":
+		return printMigrationStatus(ctx, migrator)
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return errors.Error("steps must be a positive number")
+			}
+		}
+		err = migrator.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			return errors.Error("migrate to requires a version")
+		}
+		version, parseErr := strconv.ParseInt(args[1], 10, 64)
+		if parseErr != nil {
+			return errors.Error("invalid version " + args[1])
+		}
+		err = migrator.To(ctx, version)
+	default:
+		return errors.Error("unknown migrate command " + command)
+	}
+	if err != nil {
+		return err
+	}
+	return printMigrationStatus(ctx, migrator)
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migration.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		state := "pending"
+		if status.Applied {
+			state = "applied at " + status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, state)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"github.com/dlshle/authnz/internal/access"
+	"github.com/dlshle/authnz/internal/config"
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/federation"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/mfa"
+	"github.com/dlshle/authnz/internal/oidc"
+	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/relation"
+	"github.com/dlshle/authnz/internal/role"
+	"github.com/dlshle/authnz/internal/sod"
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+)
+
+type stores struct {
+	subject    subject.Store
+	group      group.Store
+	policy     policy.Store
+	role       role.Store
+	relation   relation.Store
+	contract   contract.Store
+	access     access.Store
+	sod        sod.Store
+	mfa        mfa.Store
+	oidc       oidc.Store
+	federation federation.Store
+	// nil unless read replicas are configured
+	replicas *store.ReplicaSet
+}
+
+func initStores(dbConfig config.DatabaseConfig) (*stores, error) {
+	payloadFormat, err := store.ParsePayloadFormat(dbConfig.PayloadFormat)
+	if err != nil {
+		return nil, err
+	}
+	if payloadFormat == store.JSONBFormat && dbConfig.Driver != "" && dbConfig.Driver != "postgres" {
+		return nil, errors.Error("payload format jsonb requires the postgres driver")
+	}
+	if len(dbConfig.ReadReplicas) > 0 && dbConfig.Driver != "" && dbConfig.Driver != "postgres" {
+		return nil, errors.Error("read replicas require the postgres driver")
+	}
+	switch dbConfig.Driver {
+	case "", "postgres", "sqlite":
+		if err = initTxOptions(dbConfig); err != nil {
+			return nil, err
+		}
+		db, err := openDB(dbConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err = execMigrationScript(db); err != nil {
+			return nil, err
+		}
+		s := &stores{
+			subject:    subject.NewSQLStore(db),
+			group:      group.NewSQLStore(db, payloadFormat),
+			policy:     policy.NewSQLStore(db, payloadFormat),
+			role:       role.NewSQLStore(db, payloadFormat),
+			relation:   relation.NewSQLStore(db),
+			contract:   contract.NewContractStore(db),
+			access:     access.NewSQLStore(db, payloadFormat),
+			sod:        sod.NewSQLStore(db, payloadFormat),
+			mfa:        mfa.NewSQLStore(db),
+			oidc:       oidc.NewSQLStore(db),
+			federation: federation.NewSQLStore(db),
+		}
+		if len(dbConfig.ReadReplicas) > 0 {
+			if s.replicas, err = openReplicas(db, dbConfig); err != nil {
+				return nil, err
+			}
+			s.subject = subject.NewReplicatedSQLStore(s.replicas)
+			s.group = group.NewReplicatedSQLStore(s.replicas, payloadFormat)
+			s.policy = policy.NewReplicatedSQLStore(s.replicas, payloadFormat)
+			s.role = role.NewReplicatedSQLStore(s.replicas, payloadFormat)
+			s.relation = relation.NewReplicatedSQLStore(s.replicas)
+			s.contract = contract.NewReplicatedContractStore(s.replicas)
+			s.access = access.NewReplicatedSQLStore(s.replicas, payloadFormat)
+			s.sod = sod.NewReplicatedSQLStore(s.replicas, payloadFormat)
+		}
+		return s, nil
+	case "memory":
+		db := store.NewMemoryDB()
+		return &stores{
+			subject:    subject.NewMemoryStore(db),
+			group:      group.NewMemoryStore(db),
+			policy:     policy.NewMemoryStore(db),
+			role:       role.NewMemoryStore(db),
+			relation:   relation.NewMemoryStore(db),
+			contract:   contract.NewMemoryStore(db),
+			access:     access.NewMemoryStore(db),
+			sod:        sod.NewMemoryStore(db),
+			mfa:        mfa.NewMemoryStore(db),
+			oidc:       oidc.NewMemoryStore(db),
+			federation: federation.NewMemoryStore(db),
+		}, nil
+	case "kv":
+		db, err := store.OpenKVDB(dbConfig.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &stores{
+			subject:    subject.NewKVStore(db),
+			group:      group.NewKVStore(db),
+			policy:     policy.NewKVStore(db),
+			role:       role.NewKVStore(db),
+			relation:   relation.NewKVStore(db),
+			contract:   contract.NewKVStore(db),
+			access:     access.NewKVStore(db),
+			sod:        sod.NewKVStore(db),
+			mfa:        mfa.NewKVStore(db),
+			oidc:       oidc.NewKVStore(db),
+			federation: federation.NewKVStore(db),
+		}, nil
+	}
+	return nil, errors.Error("unknown database driver " + dbConfig.Driver)
+}
+
+func initTxOptions(dbConfig config.DatabaseConfig) (err error) {
+	if store.DefaultTxOptions.Isolation, err = store.ParseIsolationLevel(dbConfig.IsolationLevel); err != nil {
+		return err
+	}
+	if dbConfig.TxRetries < 0 {
+		store.DefaultTxOptions.MaxRetries = 0
+	} else if dbConfig.TxRetries > 0 {
+		store.DefaultTxOptions.MaxRetries = dbConfig.TxRetries
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ApproverChecker evaluates the approval policy of a request for a would-be approver
+type ApproverChecker interface {
+	Check(ctx context.Context, policyID string, authCtx *pb.AuthContext) (pb.Verdict, error)
+}
+
+type Handler struct {
+	store         Store
+	groupStore    group.Store
+	contractStore contract.Store
+	guard         contract.Guard
+	checker       ApproverChecker
+	logger        logging.Logger
+}
+
+func NewHandler(store Store, groupStore group.Store, contractStore contract.Store, guard contract.Guard, checker ApproverChecker) *Handler {
+	return &Handler{
+		store:         store,
+		groupStore:    groupStore,
+		contractStore: contractStore,
+		guard:         guard,
+		checker:       checker,
+		logger:        logging.GlobalLogger.WithPrefix("[AccessRequestHandler]"),
+	}
+}
+
+// RequestAccess files a pending request for the subject to join the group, the group's approval policy
+// at this time decides who approves it
+func (h *Handler) RequestAccess(ctx context.Context, subjectID, groupID, reason string, durationSeconds int64) (*pb.AccessRequest, error) {
+	if subjectID == "" || groupID == "" {
+		return nil, errors.Error("subject id and group id are required")
+	}
+	if durationSeconds < 0 {
+		return nil, errors.Error("duration can not be negative")
+	}
+	group, err := h.groupStore.Get(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.ApprovalPolicyId == "" {
+		return nil, errors.Error("group " + groupID + " does not accept access requests")
+	}
+	var request *pb.AccessRequest
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		// sql stores also reject a concurrent duplicate through a unique index when the transactions commit
+		pending, err := h.store.TxListPending(tx, subjectID)
+		if err != nil {
+			return err
+		}
+		for _, existing := range pending {
+			if existing.GroupId == groupID {
+				return &store.DuplicateError{Msg: "subject " + subjectID + " already has a pending access request for group " + groupID}
+			}
+		}
+		request, err = h.store.TxPut(tx, &pb.AccessRequest{
+			SubjectId:        subjectID,
+			GroupId:          groupID,
+			Reason:           reason,
+			DurationSeconds:  durationSeconds,
+			State:            pb.AccessRequest_PENDING,
+			ApprovalPolicyId: group.ApprovalPolicyId,
+			CreatedAt:        time.Now().Unix(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (h *Handler) GetAccessRequest(ctx context.Context, requestID string) (*pb.AccessRequest, error) {
+	return h.store.Get(ctx, requestID)
+}
+
+// CancelAccessRequest withdraws a pending request, only the requesting subject can cancel it
+func (h *Handler) CancelAccessRequest(ctx context.Context, requestID, subjectID string) (*pb.AccessRequest, error) {
+	request, err := h.pendingRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.SubjectId != subjectID {
+		return nil, status.Error(codes.PermissionDenied, "only the requester can cancel an access request")
+	}
+	request.State = pb.AccessRequest_CANCELLED
+	request.DecidedAt = time.Now().Unix()
+	return h.store.Put(request)
+}
+
+// Approve creates a contract for the request, bounded by the requested duration, and marks the request approved
+// in the same transaction
+func (h *Handler) Approve(ctx context.Context, requestID, approverID, reason string) (*pb.AccessRequest, error) {
+	request, err := h.decidableRequest(ctx, requestID, approverID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	window := contract.Window{}
+	if request.DurationSeconds > 0 {
+		expiresAt := now.Add(time.Duration(request.DurationSeconds) * time.Second).UTC()
+		window.ExpiresAt = &expiresAt
+	}
+	request.State = pb.AccessRequest_APPROVED
+	request.DecidedBy = approverID
+	request.DecisionReason = reason
+	request.DecidedAt = now.Unix()
+	check, err := h.guard.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var approved *pb.AccessRequest
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		// the transaction is run again on serialization failures and TxPut sets the version of the request it
+		// is given, every attempt starts from the request read above whose version keeps a concurrent decision
+		// from being overwritten
+		attempt := proto.Clone(request).(*pb.AccessRequest)
+		if err := check(tx, attempt.SubjectId, []string{attempt.GroupId}); err != nil {
+			return err
+		}
+		added, err := h.contractStore.TxAddNewContract(tx, attempt.SubjectId, attempt.GroupId, window)
+		if err != nil {
+			return err
+		}
+		attempt.ContractId = added.Id
+		approved, err = h.store.TxPut(tx, attempt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Infof(ctx, "access request %s of subject %s to group %s is approved by %s", approved.Id, approved.SubjectId, approved.GroupId, approverID)
+	return approved, nil
+}
+
+func (h *Handler) Deny(ctx context.Context, requestID, approverID, reason string) (*pb.AccessRequest, error) {
+	request, err := h.decidableRequest(ctx, requestID, approverID)
+	if err != nil {
+		return nil, err
+	}
+	request.State = pb.AccessRequest_DENIED
+	request.DecidedBy = approverID
+	request.DecisionReason = reason
+	request.DecidedAt = time.Now().Unix()
+	return h.store.Put(request)
+}
+
+// ListPendingForApprover returns the pending requests the approver may decide, the approver's own requests
+// are left out
+func (h *Handler) ListPendingForApprover(ctx context.Context, approverID string) ([]*pb.AccessRequest, error) {
+	if approverID == "" {
+		return nil, errors.Error("approver id is required")
+	}
+	pending, err := h.store.ListPending(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	// requests mostly share a handful of approval policies
+	approves := make(map[string]bool)
+	requests := []*pb.AccessRequest{}
+	for _, request := range pending {
+		if request.SubjectId == approverID {
+			continue
+		}
+		approved, checked := approves[request.ApprovalPolicyId]
+		if !checked {
+			if approved, err = h.isApprover(ctx, request, approverID); err != nil {
+				return nil, err
+			}
+			approves[request.ApprovalPolicyId] = approved
+		}
+		if approved {
+			requests = append(requests, request)
+		}
+	}
+	return requests, nil
+}
+
+func (h *Handler) decidableRequest(ctx context.Context, requestID, approverID string) (*pb.AccessRequest, error) {
+	if approverID == "" {
+		return nil, errors.Error("approver id is required")
+	}
+	request, err := h.pendingRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.SubjectId == approverID {
+		return nil, status.Error(codes.PermissionDenied, "subjects can not decide their own access requests")
+	}
+	approved, err := h.isApprover(ctx, request, approverID)
+	if err != nil {
+		return nil, err
+	}
+	if !approved {
+		return nil, status.Error(codes.PermissionDenied, approverID+" is not an approver of access request "+requestID)
+	}
+	return request, nil
+}
+
+func (h *Handler) pendingRequest(ctx context.Context, requestID string) (*pb.AccessRequest, error) {
+	// decisions read from the primary, a lagging replica may still show a decided request as pending
+	var request *pb.AccessRequest
+	err := h.store.WithTx(ctx, func(tx store.SQLTransactional) (err error) {
+		request, err = h.store.TxGet(tx, requestID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if request.State != pb.AccessRequest_PENDING {
+		return nil, errors.Error("access request " + requestID + " is already " + request.State.String())
+	}
+	return request, nil
+}
+
+func (h *Handler) isApprover(ctx context.Context, request *pb.AccessRequest, approverID string) (bool, error) {
+	verdict, err := h.checker.Check(ctx, request.ApprovalPolicyId, &pb.AuthContext{SubjectId: approverID})
+	if err != nil {
+		return false, err
+	}
+	return verdict == pb.Verdict_PERMITTED, nil
+}
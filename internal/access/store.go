@@ -0,0 +1,171 @@
+package access
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/utils"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type Store interface {
+	// Get may read from a read replica when the store has any
+	Get(ctx context.Context, id string) (*pb.AccessRequest, error)
+	// TxGet reads in tx, decisions read through it so they see the latest state of a request
+	TxGet(tx store.SQLTransactional, id string) (*pb.AccessRequest, error)
+	Put(request *pb.AccessRequest) (*pb.AccessRequest, error)
+	// TxPut fails with a store.DuplicateError when the subject already has a pending request for the group
+	TxPut(tx store.SQLTransactional, request *pb.AccessRequest) (*pb.AccessRequest, error)
+	WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error
+	// ListPending returns the requests waiting for a decision, subjectID narrows them to the requests of a subject when set.
+	// It may read from a read replica.
+	ListPending(ctx context.Context, subjectID string) ([]*pb.AccessRequest, error)
+	TxListPending(tx store.SQLTransactional, subjectID string) ([]*pb.AccessRequest, error)
+}
+
+// PBAccessRequestStore works on any entity store backend, only the sql one has the indexed columns
+type PBAccessRequestStore struct {
+	pbEntityStore store.PBEntityStore
+	format        store.PayloadFormat
+	// sql stores copy the subject, group and state of requests into indexed columns
+	indexColumns bool
+}
+
+func NewSQLStore(db *sqlx.DB, format store.PayloadFormat) Store {
+	return &PBAccessRequestStore{pbEntityStore: store.NewSQLPBEntityStore(db, "access_requests"), format: format, indexColumns: true}
+}
+
+func NewReplicatedSQLStore(replicas *store.ReplicaSet, format store.PayloadFormat) Store {
+	return &PBAccessRequestStore{pbEntityStore: store.NewReplicatedSQLPBEntityStore(replicas, "access_requests"), format: format, indexColumns: true}
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &PBAccessRequestStore{pbEntityStore: store.NewKVPBEntityStore(db, "access_requests")}
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &PBAccessRequestStore{pbEntityStore: store.NewMemoryPBEntityStore(db, "access_requests")}
+}
+
+func (s *PBAccessRequestStore) Get(ctx context.Context, id string) (request *pb.AccessRequest, err error) {
+	err = s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) error {
+		request, err = s.TxGet(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *PBAccessRequestStore) TxGet(tx store.SQLTransactional, id string) (*pb.AccessRequest, error) {
+	pbEntity, err := s.pbEntityStore.TxGet(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	request := &pb.AccessRequest{}
+	err = store.DecodePBEntity(pbEntity, request)
+	return request, err
+}
+
+func (s *PBAccessRequestStore) Put(request *pb.AccessRequest) (ret *pb.AccessRequest, err error) {
+	err = s.pbEntityStore.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		ret, err = s.TxPut(tx, request)
+		return err
+	})
+	return
+}
+
+func (s *PBAccessRequestStore) TxPut(tx store.SQLTransactional, request *pb.AccessRequest) (ret *pb.AccessRequest, err error) {
+	var (
+		pbEntity *store.PBEntity
+	)
+	err = utils.ProcessWithErrors(func() error {
+		if request.Id == "" {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			request.Id = newID.String()
+		}
+		return nil
+	}, func() error {
+		pbEntity, err = store.EncodePBEntity(request.Id, request, s.format)
+		return err
+	}, func() error {
+		pbEntity, err = s.pbEntityStore.TxPut(tx, pbEntity)
+		return err
+	}, func() error {
+		if !s.indexColumns {
+			return nil
+		}
+		return TxPutColumns(tx, request)
+	}, func() error {
+		request.Id = pbEntity.ID
+		request.Version = pbEntity.Version
+		return nil
+	})
+	return request, err
+}
+
+// TxPutColumns copies the subject, group and state of the request into the indexed columns of its row, a second
+// pending request of a subject for a group violates access_requests_pending_key
+func TxPutColumns(tx store.SQLTransactional, request *pb.AccessRequest) error {
+	_, err := tx.Exec("UPDATE access_requests SET subject_id = $2, group_id = $3, state = $4 WHERE id = $1",
+		request.Id, request.SubjectId, request.GroupId, request.State.String())
+	return store.TranslateError(err, "pending access request of subject "+request.SubjectId+" for group "+request.GroupId)
+}
+
+func (s *PBAccessRequestStore) WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error {
+	return s.pbEntityStore.WithTx(ctx, cb)
+}
+
+func (s *PBAccessRequestStore) ListPending(ctx context.Context, subjectID string) (requests []*pb.AccessRequest, err error) {
+	err = s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) error {
+		requests, err = s.TxListPending(tx, subjectID)
+		return err
+	})
+	return
+}
+
+func (s *PBAccessRequestStore) TxListPending(tx store.SQLTransactional, subjectID string) ([]*pb.AccessRequest, error) {
+	entities, err := s.findPending(tx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	requests := []*pb.AccessRequest{}
+	for _, entity := range entities {
+		request := &pb.AccessRequest{}
+		if err = store.DecodePBEntity(entity, request); err != nil {
+			return nil, err
+		}
+		if request.State == pb.AccessRequest_PENDING && (subjectID == "" || request.SubjectId == subjectID) {
+			requests = append(requests, request)
+		}
+	}
+	return requests, nil
+}
+
+// findPending returns a superset of the pending requests of subjectID, or of every subject when it is empty
+func (s *PBAccessRequestStore) findPending(tx store.SQLTransactional, subjectID string) ([]*store.PBEntity, error) {
+	if !s.indexColumns {
+		containment, err := protojson.Marshal(&pb.AccessRequest{State: pb.AccessRequest_PENDING, SubjectId: subjectID})
+		if err != nil {
+			return nil, err
+		}
+		return s.pbEntityStore.TxFindByDocument(tx, containment)
+	}
+	query, args := "SELECT id, payload, document, version FROM access_requests WHERE state = $1", []interface{}{pb.AccessRequest_PENDING.String()}
+	if subjectID != "" {
+		query, args = query+" AND subject_id = $2", append(args, subjectID)
+	}
+	rows := []store.PBEntity{}
+	if err := tx.Select(&rows, query, args...); err != nil {
+		return nil, err
+	}
+	entities := make([]*store.PBEntity, len(rows), len(rows))
+	for i := range rows {
+		entities[i] = &rows[i]
+	}
+	return entities, nil
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"strings"
+
+	"github.com/dlshle/gommon/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	MethodBearer     = "bearer"
+	MethodClientCert = "client_cert"
+)
+
+// Principal is the authenticated caller of an rpc
+type Principal struct {
+	SubjectID string
+	Method    string
+	// bootstrap admins skip policy checks so the first policies can be created
+	Bootstrap bool
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// Authenticator returns a nil principal without error when the request carries no credential it understands
+type Authenticator interface {
+	Authenticate(ctx context.Context) (*Principal, error)
+}
+
+type TokenVerifier interface {
+	// VerifyAccessToken returns the subject id the token was issued to
+	VerifyAccessToken(token string) (string, error)
+}
+
+type BootstrapAdmin struct {
+	SubjectID    string
+	Token        string
+	ClientCertCN string
+}
+
+type bearerAuthenticator struct {
+	bootstrap BootstrapAdmin
+	verifier  TokenVerifier
+}
+
+// NewBearerAuthenticator accepts the bootstrap admin token and, when verifier is not nil, access tokens
+func NewBearerAuthenticator(bootstrap BootstrapAdmin, verifier TokenVerifier) Authenticator {
+	return &bearerAuthenticator{bootstrap: bootstrap, verifier: verifier}
+}
+
+func (a *bearerAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, errors.Error("unsupported authorization scheme")
+	}
+	token := strings.TrimPrefix(values[0], "Bearer ")
+	if a.bootstrap.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.bootstrap.Token)) == 1 {
+		return &Principal{SubjectID: a.bootstrap.SubjectID, Method: MethodBearer, Bootstrap: true}, nil
+	}
+	if a.verifier == nil {
+		return nil, errors.Error("bearer token is not accepted")
+	}
+	subjectID, err := a.verifier.VerifyAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+	// only the configured bootstrap credentials are bootstrap admins, access tokens are authorized by policies
+	// even when they are issued to the bootstrap subject
+	return &Principal{SubjectID: subjectID, Method: MethodBearer}, nil
+}
+
+const (
+	CertSubjectFromCN    = "cn"
+	CertSubjectFromURI   = "uri"
+	CertSubjectFromDNS   = "dns"
+	CertSubjectFromEmail = "email"
+)
+
+// CertSubjectMapping tells where the subject id of a client certificate comes from
+type CertSubjectMapping struct {
+	Source string
+	// stripped from the identity, e.g. spiffe://authnz/subject/
+	Prefix string
+}
+
+func (m CertSubjectMapping) SubjectID(cert *x509.Certificate) (string, error) {
+	var identities []string
+	switch m.Source {
+	case "", CertSubjectFromCN:
+		identities = []string{cert.Subject.CommonName}
+	case CertSubjectFromURI:
+		for _, uri := range cert.URIs {
+			identities = append(identities, uri.String())
+		}
+	case CertSubjectFromDNS:
+		identities = cert.DNSNames
+	case CertSubjectFromEmail:
+		identities = cert.EmailAddresses
+	default:
+		return "", errors.Error("unsupported client subject source " + m.Source)
+	}
+	for _, identity := range identities {
+		if identity != "" && strings.HasPrefix(identity, m.Prefix) {
+			return strings.TrimPrefix(identity, m.Prefix), nil
+		}
+	}
+	return "", errors.Error("no " + m.Source + " identity with prefix " + m.Prefix + " in client certificate")
+}
+
+type clientCertAuthenticator struct {
+	bootstrap BootstrapAdmin
+	mapping   CertSubjectMapping
+}
+
+// NewClientCertAuthenticator identifies callers by their verified client certificate
+func NewClientCertAuthenticator(bootstrap BootstrapAdmin, mapping CertSubjectMapping) Authenticator {
+	return &clientCertAuthenticator{bootstrap: bootstrap, mapping: mapping}
+}
+
+func (a *clientCertAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	cert := verifiedClientCert(ctx)
+	if cert == nil {
+		return nil, nil
+	}
+	if a.bootstrap.ClientCertCN != "" && cert.Subject.CommonName == a.bootstrap.ClientCertCN {
+		return &Principal{SubjectID: a.bootstrap.SubjectID, Method: MethodClientCert, Bootstrap: true}, nil
+	}
+	subjectID, err := a.mapping.SubjectID(cert)
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{SubjectID: subjectID, Method: MethodClientCert}, nil
+}
+
+func verifiedClientCert(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
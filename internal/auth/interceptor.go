@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"path"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rpcs that serve authentication/authorization decisions, callers only need to be authenticated
+var decisionMethods = map[string]bool{
+	"authorize":       true,
+	"checkPermission": true,
+	"checkRelation":   true,
+}
+
+// rpcs that act as the authenticated caller and authorize it themselves, e.g. against a group's approval policy
+var selfServiceMethods = map[string]bool{
+	"requestAccess":             true,
+	"cancelAccessRequest":       true,
+	"approveAccessRequest":      true,
+	"denyAccessRequest":         true,
+	"listPendingAccessRequests": true,
+	"confirmTOTP":               true,
+	"verifyTOTP":                true,
+	"redeemRecoveryCode":        true,
+}
+
+type PolicyChecker interface {
+	Check(ctx context.Context, policyID string, authCtx *pb.AuthContext) (pb.Verdict, error)
+}
+
+type Options struct {
+	// policy checked for management rpcs that have no entry in MethodPolicies
+	DefaultPolicyID string
+	// keyed by rpc name, e.g. createPolicy
+	MethodPolicies map[string]string
+}
+
+type Interceptor struct {
+	authenticators []Authenticator
+	checker        PolicyChecker
+	opts           Options
+	logger         logging.Logger
+}
+
+func NewInterceptor(checker PolicyChecker, opts Options, authenticators ...Authenticator) *Interceptor {
+	return &Interceptor{
+		authenticators: authenticators,
+		checker:        checker,
+		opts:           opts,
+		logger:         logging.GlobalLogger.WithPrefix("[AuthInterceptor]"),
+	}
+}
+
+func (i *Interceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	principal, err := i.authenticate(ctx)
+	if err != nil {
+		i.logger.Warnf(ctx, "[%s] authentication failed due to %s", info.FullMethod, err.Error())
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	ctx = WithPrincipal(ctx, principal)
+	if err = i.authorize(ctx, principal, info.FullMethod); err != nil {
+		i.logger.Warnf(ctx, "[%s] subject %s is not authorized due to %s", info.FullMethod, principal.SubjectID, err.Error())
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *Interceptor) authenticate(ctx context.Context) (*Principal, error) {
+	for _, authenticator := range i.authenticators {
+		principal, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if principal != nil {
+			return principal, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "no credential is presented")
+}
+
+func (i *Interceptor) authorize(ctx context.Context, principal *Principal, fullMethod string) error {
+	method := path.Base(fullMethod)
+	if principal.Bootstrap || decisionMethods[method] || selfServiceMethods[method] {
+		return nil
+	}
+	policyID, ok := i.opts.MethodPolicies[method]
+	if !ok {
+		policyID = i.opts.DefaultPolicyID
+	}
+	if policyID == "" {
+		// fail closed when no policy guards the rpc
+		return status.Error(codes.PermissionDenied, "no policy is configured for "+method)
+	}
+	verdict, err := i.checker.Check(ctx, policyID, &pb.AuthContext{
+		SubjectId:       principal.SubjectID,
+		ContextProperty: []*pb.ContextProperty{{Key: "rpc", Value: method}},
+	})
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if verdict != pb.Verdict_PERMITTED {
+		return status.Error(codes.PermissionDenied, "permission denied for "+method)
+	}
+	return nil
+}
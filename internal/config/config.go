@@ -3,20 +3,177 @@ package config
 import "github.com/dlshle/authnz/pkg/yaml"
 
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Database DatabaseConfig `yaml:"database"`
+	Server     ServerConfig     `yaml:"server"`
+	Database   DatabaseConfig   `yaml:"database"`
+	MFA        MFAConfig        `yaml:"mfa"`
+	OIDC       OIDCConfig       `yaml:"oidc"`
+	Federation FederationConfig `yaml:"federation"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Relations  RelationsConfig  `yaml:"relations"`
+	Contracts  ContractsConfig  `yaml:"contracts"`
 }
 
 type ServerConfig struct {
-	GRPC string `yaml:"grpc"`
+	GRPC string    `yaml:"grpc"`
+	TLS  TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig enables tls when cert_file is set, rotated files are picked up without restart
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// enables client certificate verification
+	ClientCAFile      string `yaml:"client_ca_file"`
+	RequireClientCert bool   `yaml:"require_client_cert"`
+	// where the subject id of a client certificate comes from: cn (default), uri, dns or email
+	ClientSubjectSource string `yaml:"client_subject_source"`
+	// stripped from the client certificate identity, e.g. spiffe://authnz/subject/
+	ClientSubjectPrefix string `yaml:"client_subject_prefix"`
 }
 
 type DatabaseConfig struct {
-	Host   string `yaml:"host"`
-	Port   int    `yaml:"port"`
-	DBName string `yaml:"db_name"`
-	User   string `yaml:"user"`
-	Pass   string `yaml:"pass"`
+	// postgres (default), sqlite, kv or memory, the memory driver keeps everything in process and loses it on restart
+	Driver string `yaml:"driver"`
+	// database file of the sqlite driver or data directory of the kv driver
+	Path string `yaml:"path"`
+	// protobuf (default) or jsonb, jsonb stores groups and policies as queryable documents and needs postgres
+	PayloadFormat string `yaml:"payload_format"`
+	// isolation level of sql transactions, e.g. serializable, defaults to the one of the database
+	IsolationLevel string `yaml:"isolation_level"`
+	// how often a transaction failing with a serialization failure or deadlock is retried, defaults to 3, negative disables
+	TxRetries int    `yaml:"tx_retries"`
+	Host      string `yaml:"host"`
+	Port      int    `yaml:"port"`
+	DBName    string `yaml:"db_name"`
+	User      string `yaml:"user"`
+	Pass      string `yaml:"pass"`
+	// read the password from a file (e.g. a mounted secret) or an environment variable instead of pass
+	PassFile string `yaml:"pass_file"`
+	PassEnv  string `yaml:"pass_env"`
+	// libpq ssl settings, ssl_mode defaults to disable
+	SSLMode     string `yaml:"ssl_mode"`
+	SSLCert     string `yaml:"ssl_cert"`
+	SSLKey      string `yaml:"ssl_key"`
+	SSLRootCert string `yaml:"ssl_root_cert"`
+	// connection pool, zero keeps the database/sql defaults
+	MaxOpenConns           int `yaml:"max_open_conns"`
+	MaxIdleConns           int `yaml:"max_idle_conns"`
+	ConnMaxLifetimeSeconds int `yaml:"conn_max_lifetime_seconds"`
+	ConnMaxIdleTimeSeconds int `yaml:"conn_max_idle_time_seconds"`
+	ConnectTimeoutSeconds  int `yaml:"connect_timeout_seconds"`
+	// how often the startup ping is retried with exponential backoff, defaults to 5, negative disables
+	ConnectRetries int `yaml:"connect_retries"`
+	// postgres DSNs of read replicas that serve the reads of authorization checks
+	ReadReplicas []string `yaml:"read_replicas"`
+	// replicas lagging further behind are skipped in favour of the primary, defaults to 5
+	MaxReplicaLagSeconds int `yaml:"max_replica_lag_seconds"`
+	// how long a caller reads from the primary after a write, defaults to 10
+	ReadYourWritesSeconds int `yaml:"read_your_writes_seconds"`
+}
+
+type MFAConfig struct {
+	// shown as the issuer in authenticator apps
+	Issuer            string `yaml:"issuer"`
+	SessionTTLSeconds int    `yaml:"session_ttl_seconds"`
+}
+
+type OIDCConfig struct {
+	// address of the http listener, the provider is disabled when empty
+	HTTP string `yaml:"http"`
+	// serves https when cert_file is set, client certificates are not used by the provider
+	TLS    TLSConfig `yaml:"tls"`
+	Issuer string    `yaml:"issuer"`
+	// PEM encoded RSA key, an ephemeral key is generated when empty
+	SigningKeyFile  string             `yaml:"signing_key_file"`
+	CodeTTLSeconds  int                `yaml:"code_ttl_seconds"`
+	TokenTTLSeconds int                `yaml:"token_ttl_seconds"`
+	Clients         []OIDCClientConfig `yaml:"clients"`
+}
+
+type OIDCClientConfig struct {
+	ID string `yaml:"id"`
+	// public clients (no secret) must rely on PKCE only
+	Secret       string   `yaml:"secret"`
+	RedirectURIs []string `yaml:"redirect_uris"`
+}
+
+type FederationConfig struct {
+	Issuers []UpstreamIssuerConfig `yaml:"issuers"`
+}
+
+type UpstreamIssuerConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	JWKSURL  string `yaml:"jwks_url"`
+	// takes precedence over jwks_url, mostly for tests
+	JWKSFile      string `yaml:"jwks_file"`
+	AutoProvision bool   `yaml:"auto_provision"`
+	// claim used as the user id of provisioned subjects, defaults to sub
+	UserIDClaim   string               `yaml:"user_id_claim"`
+	ClaimMappings []ClaimMappingConfig `yaml:"claim_mappings"`
+}
+
+type ClaimMappingConfig struct {
+	Claim     string `yaml:"claim"`
+	Attribute string `yaml:"attribute"`
+	Default   string `yaml:"default"`
+}
+
+// AuthConfig guards authnz's own grpc api with authnz policies
+type AuthConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// policy checked for management rpcs that have no entry in method_policies
+	DefaultPolicyID string `yaml:"default_policy_id"`
+	// rpc name (e.g. createPolicy) to policy id
+	MethodPolicies map[string]string    `yaml:"method_policies"`
+	BootstrapAdmin BootstrapAdminConfig `yaml:"bootstrap_admin"`
+}
+
+// BootstrapAdminConfig identifies a caller that bypasses policy checks, needed to create the first policies
+type BootstrapAdminConfig struct {
+	SubjectID    string `yaml:"subject_id"`
+	Token        string `yaml:"token"`
+	ClientCertCN string `yaml:"client_cert_cn"`
+}
+
+type ContractsConfig struct {
+	// how often expired contracts are deleted, defaults to 60, negative disables the reaper
+	ReapIntervalSeconds int `yaml:"reap_interval_seconds"`
+	// longest break-glass membership, also the default when a request asks for none, defaults to 3600
+	BreakGlassMaxDurationSeconds int `yaml:"break_glass_max_duration_seconds"`
+}
+
+// RelationsConfig declares the namespaces of relationship based checks, tuples can only be written
+// for declared namespaces and relations
+type RelationsConfig struct {
+	Namespaces []NamespaceConfig `yaml:"namespaces"`
+}
+
+type NamespaceConfig struct {
+	Name      string           `yaml:"name"`
+	Relations []RelationConfig `yaml:"relations"`
+}
+
+// RelationConfig without a rewrite is only granted by direct tuples
+type RelationConfig struct {
+	Name    string         `yaml:"name"`
+	Rewrite *RewriteConfig `yaml:"rewrite"`
+}
+
+// RewriteConfig sets exactly one of its fields
+type RewriteConfig struct {
+	// the direct tuples of the relation
+	This bool `yaml:"this"`
+	// another relation of the same object, e.g. owners are editors
+	ComputedUserset string `yaml:"computed_userset"`
+	// a relation of the objects related by tupleset, e.g. the viewers of the parent folder
+	TupleToUserset *TupleToUsersetConfig `yaml:"tuple_to_userset"`
+	Union          []RewriteConfig       `yaml:"union"`
+}
+
+type TupleToUsersetConfig struct {
+	Tupleset        string `yaml:"tupleset"`
+	ComputedUserset string `yaml:"computed_userset"`
 }
 
 func Load(path string) (Config, error) {
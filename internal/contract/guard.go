@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+)
+
+// Guard vets contracts before they are added. Prepare runs before the transaction adding them, so it can
+// read what it needs without holding the transaction, and the check it returns runs within it.
+type Guard interface {
+	Prepare(ctx context.Context) (GuardCheck, error)
+}
+
+// GuardCheck fails when the subject may not get contracts with groupIDs on top of the ones it holds in tx
+type GuardCheck func(tx store.SQLTransactional, subjectID string, groupIDs []string) error
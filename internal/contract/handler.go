@@ -2,21 +2,112 @@ package contract
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+)
+
+const (
+	defaultMembersPageSize = 100
+	maxMembersPageSize     = 1000
 )
 
 type Handler struct {
-	store Store
+	store                 Store
+	guard                 Guard
+	breakGlassMaxDuration time.Duration
+	logger                logging.Logger
 }
 
-func NewHandler(store Store) *Handler {
-	return &Handler{store: store}
+func NewHandler(store Store, guard Guard, breakGlassMaxDuration time.Duration) *Handler {
+	return &Handler{store: store, guard: guard, breakGlassMaxDuration: breakGlassMaxDuration, logger: logging.GlobalLogger.WithPrefix("[ContractHandler]")}
 }
 
 func (h *Handler) CreateContract(ctx context.Context, contract *pb.Contract) (*pb.ContractResponse, error) {
-	contract, err := h.store.AddNewContract(contract.SubjectId, contract.GroupId)
-	return &pb.ContractResponse{Contract: contract}, err
+	window, err := WindowFromPB(contract.NotBefore, contract.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	added, err := h.addGuarded(ctx, contract.SubjectId, contract.GroupId, func(tx store.SQLTransactional) (*pb.Contract, error) {
+		return h.store.TxAddNewContract(tx, contract.SubjectId, contract.GroupId, window)
+	})
+	return &pb.ContractResponse{Contract: added}, err
+}
+
+// addGuarded adds the contract with add once the guard accepts it, in the same transaction
+func (h *Handler) addGuarded(ctx context.Context, subjectID, groupID string, add func(tx store.SQLTransactional) (*pb.Contract, error)) (added *pb.Contract, err error) {
+	check, err := h.guard.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		if err := check(tx, subjectID, []string{groupID}); err != nil {
+			return err
+		}
+		added, err = add(tx)
+		return err
+	})
+	return
+}
+
+// UpdateContractWindow replaces the window of the contract, a window that already ended makes the contract
+// ineffective at once and the reaper deletes it. Break-glass contracts keep their window.
+func (h *Handler) UpdateContractWindow(ctx context.Context, contractID string, notBefore, expiresAt int64) (*pb.ContractResponse, error) {
+	window, err := WindowFromPB(notBefore, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := h.store.UpdateContractWindow(ctx, contractID, window)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ContractResponse{Contract: contract}, nil
+}
+
+// BreakGlass adds the subject to the group at once, without an access request, for duration or for the
+// longest break-glass membership when duration is 0. The justification is kept on the contract.
+func (h *Handler) BreakGlass(ctx context.Context, subjectID, groupID, justification, grantedBy string, duration time.Duration) (*pb.ContractResponse, error) {
+	if strings.TrimSpace(justification) == "" {
+		return nil, errors.Error("a justification is required for break-glass access")
+	}
+	if duration == 0 {
+		duration = h.breakGlassMaxDuration
+	}
+	if duration < 0 || duration > h.breakGlassMaxDuration {
+		return nil, errors.Error("break-glass access lasts at most " + h.breakGlassMaxDuration.String())
+	}
+	expiresAt := time.Now().Add(duration).UTC()
+	breakGlass := BreakGlass{Justification: &justification}
+	if grantedBy != "" {
+		breakGlass.GrantedBy = &grantedBy
+	}
+	// break-glass skips approval but not separation of duties
+	contract, err := h.addGuarded(ctx, subjectID, groupID, func(tx store.SQLTransactional) (*pb.Contract, error) {
+		return h.store.TxAddBreakGlassContract(tx, subjectID, groupID, Window{ExpiresAt: &expiresAt}, breakGlass)
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Warnf(ctx, "BREAK-GLASS contract %s grants subject %s group %s until %s, granted by %q with justification %q",
+		contract.Id, subjectID, groupID, expiresAt.Format(time.RFC3339), grantedBy, justification)
+	return &pb.ContractResponse{Contract: contract}, nil
+}
+
+// RecordBreakGlassDecision keeps one audit record per break-glass contract the decision was made with, check
+// names what was decided
+func (h *Handler) RecordBreakGlassDecision(ctx context.Context, subjectID string, contractIDs []string, check string, verdict pb.Verdict) error {
+	h.logger.Warnf(ctx, "BREAK-GLASS decision %s on %s for subject %s made with contracts %v", verdict.String(), check, subjectID, contractIDs)
+	now := time.Now()
+	decisions := make([]BreakGlassDecision, len(contractIDs), len(contractIDs))
+	for i, contractID := range contractIDs {
+		decisions[i] = BreakGlassDecision{ContractID: contractID, SubjectID: subjectID, Check: check, Verdict: verdict.String(), DecidedAt: now}
+	}
+	return h.store.AddBreakGlassDecisions(ctx, decisions)
 }
 
 func (h *Handler) DeleteContract(ctx context.Context, contractID string) (*pb.EmptyResponse, error) {
@@ -24,6 +115,48 @@ func (h *Handler) DeleteContract(ctx context.Context, contractID string) (*pb.Em
 	return &pb.EmptyResponse{}, err
 }
 
-func (h *Handler) GetGroupsBySubjectID(subjectID string) ([]*pb.Group, error) {
-	return h.store.ListGroupsBySubjectID(subjectID)
+// ListGroupMembers returns a page of the group's contracts ordered by subject id, the page token is the last
+// subject id of the previous page
+func (h *Handler) ListGroupMembers(ctx context.Context, groupID string, pageSize int, pageToken string) (*pb.GroupMembersResponse, error) {
+	if groupID == "" {
+		return nil, errors.Error("group id is required")
+	}
+	if pageSize < 0 || pageSize > maxMembersPageSize {
+		return nil, errors.Error("page size can not exceed " + strconv.Itoa(maxMembersPageSize))
+	}
+	if pageSize == 0 {
+		pageSize = defaultMembersPageSize
+	}
+	// one more than asked tells if there is a next page
+	contracts, err := h.store.ListContractsByGroupID(ctx, groupID, pageToken, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GroupMembersResponse{Members: make([]*pb.Contract, 0, pageSize)}
+	if len(contracts) > pageSize {
+		contracts = contracts[:pageSize]
+		resp.NextPageToken = contracts[pageSize-1].SubjectID
+	}
+	for _, contract := range contracts {
+		resp.Members = append(resp.Members, contract.ToPB())
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetGroupsBySubjectID(ctx context.Context, subjectID string) ([]*pb.Group, error) {
+	memberships, err := h.store.ListMembershipsBySubjectID(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*pb.Group, len(memberships), len(memberships))
+	for i, membership := range memberships {
+		groups[i] = membership.Group
+	}
+	return groups, nil
+}
+
+// GetMemberships returns the groups of the subject's contracts in effect and tells which of them were granted by
+// break-glass
+func (h *Handler) GetMemberships(ctx context.Context, subjectID string) ([]Membership, error) {
+	return h.store.ListMembershipsBySubjectID(ctx, subjectID)
 }
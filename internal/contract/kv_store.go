@@ -0,0 +1,386 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	subjectIDIndex          = "contracts_subject_id_idx"
+	groupIDIndex            = "contracts_group_id_idx"
+	decisionContractIDIndex = "break_glass_decisions_contract_id_idx"
+)
+
+type kvStore struct {
+	db     *store.KVDB
+	groups store.PBEntityStore
+}
+
+// NewKVStore enforces the same constraints as the contracts schema: unique (subject, group) and cascading deletes
+func NewKVStore(db *store.KVDB) Store {
+	s := &kvStore{db: db, groups: store.NewKVPBEntityStore(db, "groups")}
+	db.RegisterCascade("subjects", func(tx *store.KVTx, subjectID string) error {
+		return s.deleteIndexed(tx, subjectIDIndex, subjectID)
+	})
+	db.RegisterCascade("groups", func(tx *store.KVTx, groupID string) error {
+		return s.deleteIndexed(tx, groupIDIndex, groupID)
+	})
+	return s
+}
+
+func (s *kvStore) AddNewContract(subjectID, groupID string, window Window) (contract *pb.Contract, err error) {
+	err = s.db.WithTx(func(tx store.SQLTransactional) error {
+		contract, err = s.TxAddNewContract(tx, subjectID, groupID, window)
+		return err
+	})
+	return
+}
+
+func (s *kvStore) TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string, window Window) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window})
+}
+
+func (s *kvStore) TxAddBreakGlassContract(tx store.SQLTransactional, subjectID, groupID string, window Window, breakGlass BreakGlass) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window, BreakGlass: breakGlass})
+}
+
+func (s *kvStore) txInsert(tx store.SQLTransactional, contract Contract) (*pb.Contract, error) {
+	subjectID, groupID := contract.SubjectID, contract.GroupID
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := s.listIndexed(kvTx, subjectIDIndex, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range contracts {
+		if existing.GroupID == groupID {
+			return nil, &store.DuplicateError{Constraint: "contracts_subject_id_group_id_key", Msg: "contract by " + subjectID + ":" + groupID + " already exists"}
+		}
+	}
+	if subject, err := kvTx.GetRaw("subjects", subjectID); err != nil || subject == nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, &store.ReferenceError{Constraint: "contracts_subject_id_fkey", Msg: "contract by " + subjectID + ":" + groupID + " references a missing record"}
+	}
+	if group, err := kvTx.GetRaw("groups", groupID); err != nil || group == nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, &store.ReferenceError{Constraint: "contracts_group_id_fkey", Msg: "contract by " + subjectID + ":" + groupID + " references a missing record"}
+	}
+	contractID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	contract.ID = contractID.String()
+	if err = kvTx.Put(contractTable, contract.ID, contract); err != nil {
+		return nil, err
+	}
+	if err = kvTx.AddIndex(subjectIDIndex, subjectID, contract.ID); err != nil {
+		return nil, err
+	}
+	if err = kvTx.AddIndex(groupIDIndex, groupID, contract.ID); err != nil {
+		return nil, err
+	}
+	return contract.ToPB(), nil
+}
+
+func (s *kvStore) UpdateContractWindow(ctx context.Context, contractID string, window Window) (updated *pb.Contract, err error) {
+	err = s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		contract := Contract{}
+		found, err := kvTx.Get(contractTable, contractID, &contract)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errors.Error("no record is found for contractID " + contractID)
+		}
+		if err = checkWindowChange(contract); err != nil {
+			return err
+		}
+		contract.Window = window
+		updated = contract.ToPB()
+		return kvTx.Put(contractTable, contractID, contract)
+	})
+	return
+}
+
+func (s *kvStore) DeleteExpiredContracts(ctx context.Context, now time.Time) (expired []Contract, err error) {
+	err = s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		expired = []Contract{}
+		err = kvTx.Scan(contractTable, func(key string, value []byte) error {
+			contract := Contract{}
+			if err := json.Unmarshal(value, &contract); err != nil {
+				return err
+			}
+			if contract.Expired(now) {
+				expired = append(expired, contract)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, contract := range expired {
+			if err = s.delete(kvTx, contract); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s *kvStore) DeleteContract(subjectID, groupID string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		contracts, err := s.listIndexed(kvTx, subjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		for _, contract := range contracts {
+			if contract.GroupID == groupID {
+				return s.delete(kvTx, contract)
+			}
+		}
+		return errors.Error("no record is found for " + subjectID + ":" + groupID)
+	})
+}
+
+func (s *kvStore) DeleteContractByContractID(contractID string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		return s.TxDeleteContract(tx, contractID)
+	})
+}
+
+func (s *kvStore) TxDeleteContractsByGroupID(tx store.SQLTransactional, groupID string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	contracts, err := s.listIndexed(kvTx, groupIDIndex, groupID)
+	if err != nil {
+		return err
+	}
+	if len(contracts) == 0 {
+		return errors.Error("not found: no group is found by group_id " + groupID)
+	}
+	for _, contract := range contracts {
+		if err = s.delete(kvTx, contract); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *kvStore) TxDeleteContract(tx store.SQLTransactional, contractID string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	contract := Contract{}
+	found, err := kvTx.Get(contractTable, contractID, &contract)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Error("no record is found for contractID " + contractID)
+	}
+	return s.delete(kvTx, contract)
+}
+
+func (s *kvStore) ListMembershipsBySubjectID(ctx context.Context, subjectID string) (memberships []Membership, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		contracts, err := s.listIndexed(kvTx, subjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		memberships = []Membership{}
+		now := time.Now()
+		active := make(map[string]Contract, len(contracts))
+		groupIDs := make([]string, 0, len(contracts))
+		for _, contract := range contracts {
+			if contract.Active(now) {
+				active[contract.GroupID] = contract
+				groupIDs = append(groupIDs, contract.GroupID)
+			}
+		}
+		entities, err := s.groups.TxBulkGet(kvTx, groupIDs)
+		if err != nil {
+			return err
+		}
+		for _, entity := range entities {
+			membership := Membership{Group: &pb.Group{}}
+			if err = store.DecodePBEntity(entity, membership.Group); err != nil {
+				return err
+			}
+			if contract := active[entity.ID]; contract.IsBreakGlass() {
+				membership.BreakGlassContractID = contract.ID
+			}
+			memberships = append(memberships, membership)
+		}
+		return nil
+	})
+	return
+}
+
+func (s *kvStore) ListAllContractsBySubject(subjectID string) (contracts []Contract, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		contracts, err = s.TxListAllContractsBySubject(tx, subjectID)
+		return err
+	})
+	return
+}
+
+func (s *kvStore) TxListAllContractsBySubject(tx store.SQLTransactional, subjectID string) ([]Contract, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.listIndexed(kvTx, subjectIDIndex, subjectID)
+}
+
+// TxLockSubjectContracts has nothing to lock, bbolt runs one write transaction at a time
+func (s *kvStore) TxLockSubjectContracts(tx store.SQLTransactional, subjectID string) error {
+	return nil
+}
+
+func (s *kvStore) TxListContractsByGroupID(tx store.SQLTransactional, groupID string) ([]Contract, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.listIndexed(kvTx, groupIDIndex, groupID)
+}
+
+func (s *kvStore) listIndexed(tx *store.KVTx, index, value string) ([]Contract, error) {
+	ids, err := tx.Lookup(index, value)
+	if err != nil {
+		return nil, err
+	}
+	contracts := []Contract{}
+	for _, id := range ids {
+		contract := Contract{}
+		found, err := tx.Get(contractTable, id, &contract)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			contracts = append(contracts, contract)
+		}
+	}
+	return contracts, nil
+}
+
+func (s *kvStore) deleteIndexed(tx *store.KVTx, index, value string) error {
+	contracts, err := s.listIndexed(tx, index, value)
+	if err != nil {
+		return err
+	}
+	for _, contract := range contracts {
+		if err = s.delete(tx, contract); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *kvStore) delete(tx *store.KVTx, contract Contract) error {
+	if err := tx.RemoveIndex(subjectIDIndex, contract.SubjectID, contract.ID); err != nil {
+		return err
+	}
+	if err := tx.RemoveIndex(groupIDIndex, contract.GroupID, contract.ID); err != nil {
+		return err
+	}
+	_, err := tx.Delete(contractTable, contract.ID)
+	return err
+}
+
+func (s *kvStore) ListContractsByGroupID(ctx context.Context, groupID, afterSubjectID string, limit int) (contracts []Contract, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		if contracts, err = s.TxListContractsByGroupID(tx, groupID); err != nil {
+			return err
+		}
+		contracts = pageBySubjectID(contracts, afterSubjectID, limit)
+		return nil
+	})
+	return
+}
+
+func (s *kvStore) WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *kvStore) AddBreakGlassDecisions(ctx context.Context, decisions []BreakGlassDecision) error {
+	return s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		for i := range decisions {
+			decisionID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			decisions[i].ID = decisionID.String()
+			if err = kvTx.Put(decisionTable, decisions[i].ID, decisions[i]); err != nil {
+				return err
+			}
+			if err = kvTx.AddIndex(decisionContractIDIndex, decisions[i].ContractID, decisions[i].ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvStore) ListBreakGlassDecisions(ctx context.Context, contractID string) (decisions []BreakGlassDecision, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		ids, err := kvTx.Lookup(decisionContractIDIndex, contractID)
+		if err != nil {
+			return err
+		}
+		decisions = []BreakGlassDecision{}
+		for _, id := range ids {
+			decision := BreakGlassDecision{}
+			found, err := kvTx.Get(decisionTable, id, &decision)
+			if err != nil {
+				return err
+			}
+			if found {
+				decisions = append(decisions, decision)
+			}
+		}
+		sortDecisions(decisions)
+		return nil
+	})
+	return
+}
@@ -0,0 +1,285 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	contractTable = "contracts"
+	decisionTable = "break_glass_decisions"
+)
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+// NewMemoryStore enforces the same constraints as the contracts schema: unique (subject, group) and cascading deletes
+func NewMemoryStore(db *store.MemoryDB) Store {
+	db.RegisterCascade("subjects", contractTable, func(row interface{}) string {
+		return row.(Contract).SubjectID
+	})
+	db.RegisterCascade("groups", contractTable, func(row interface{}) string {
+		return row.(Contract).GroupID
+	})
+	return &memoryStore{db: db}
+}
+
+func (s *memoryStore) AddNewContract(subjectID, groupID string, window Window) (contract *pb.Contract, err error) {
+	err = s.db.WithTx(func(tx store.SQLTransactional) error {
+		contract, err = s.TxAddNewContract(tx, subjectID, groupID, window)
+		return err
+	})
+	return
+}
+
+func (s *memoryStore) TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string, window Window) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window})
+}
+
+func (s *memoryStore) TxAddBreakGlassContract(tx store.SQLTransactional, subjectID, groupID string, window Window, breakGlass BreakGlass) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window, BreakGlass: breakGlass})
+}
+
+func (s *memoryStore) txInsert(tx store.SQLTransactional, contract Contract) (*pb.Contract, error) {
+	subjectID, groupID := contract.SubjectID, contract.GroupID
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.filter(memoryTx, func(existing Contract) bool {
+		return existing.SubjectID == subjectID && existing.GroupID == groupID
+	})) > 0 {
+		return nil, &store.DuplicateError{Constraint: "contracts_subject_id_group_id_key", Msg: "contract by " + subjectID + ":" + groupID + " already exists"}
+	}
+	if _, ok := memoryTx.Get("subjects", subjectID); !ok {
+		return nil, &store.ReferenceError{Constraint: "contracts_subject_id_fkey", Msg: "contract by " + subjectID + ":" + groupID + " references a missing record"}
+	}
+	if _, ok := memoryTx.Get("groups", groupID); !ok {
+		return nil, &store.ReferenceError{Constraint: "contracts_group_id_fkey", Msg: "contract by " + subjectID + ":" + groupID + " references a missing record"}
+	}
+	contractID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	contract.ID = contractID.String()
+	memoryTx.Put(contractTable, contract.ID, contract)
+	return contract.ToPB(), nil
+}
+
+func (s *memoryStore) UpdateContractWindow(ctx context.Context, contractID string, window Window) (contract *pb.Contract, err error) {
+	err = s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		row, ok := memoryTx.Get(contractTable, contractID)
+		if !ok {
+			return errors.Error("no record is found for contractID " + contractID)
+		}
+		updated := row.(Contract)
+		if err := checkWindowChange(updated); err != nil {
+			return err
+		}
+		updated.Window = window
+		memoryTx.Put(contractTable, contractID, updated)
+		contract = updated.ToPB()
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) DeleteExpiredContracts(ctx context.Context, now time.Time) (expired []Contract, err error) {
+	err = s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		expired = s.filter(memoryTx, func(contract Contract) bool {
+			return contract.Expired(now)
+		})
+		for _, contract := range expired {
+			memoryTx.Delete(contractTable, contract.ID)
+		}
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) DeleteContract(subjectID, groupID string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		return s.deleteWhere(memoryTx, func(contract Contract) bool {
+			return contract.SubjectID == subjectID && contract.GroupID == groupID
+		}, "no record is found for "+subjectID+":"+groupID)
+	})
+}
+
+func (s *memoryStore) DeleteContractByContractID(contractID string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		return s.TxDeleteContract(tx, contractID)
+	})
+}
+
+func (s *memoryStore) TxDeleteContractsByGroupID(tx store.SQLTransactional, groupID string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	return s.deleteWhere(memoryTx, func(contract Contract) bool {
+		return contract.GroupID == groupID
+	}, "not found: no group is found by group_id "+groupID)
+}
+
+func (s *memoryStore) TxDeleteContract(tx store.SQLTransactional, contractID string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	if !memoryTx.Delete(contractTable, contractID) {
+		return errors.Error("no record is found for contractID " + contractID)
+	}
+	return nil
+}
+
+func (s *memoryStore) ListMembershipsBySubjectID(ctx context.Context, subjectID string) (memberships []Membership, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		memberships = []Membership{}
+		now := time.Now()
+		for _, contract := range s.filter(memoryTx, func(contract Contract) bool {
+			return contract.SubjectID == subjectID && contract.Active(now)
+		}) {
+			row, ok := memoryTx.Get("groups", contract.GroupID)
+			if !ok {
+				continue
+			}
+			membership := Membership{Group: &pb.Group{}}
+			if err = store.DecodePBEntity(row.(*store.PBEntity), membership.Group); err != nil {
+				return err
+			}
+			if contract.IsBreakGlass() {
+				membership.BreakGlassContractID = contract.ID
+			}
+			memberships = append(memberships, membership)
+		}
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) ListAllContractsBySubject(subjectID string) (contracts []Contract, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		contracts, err = s.TxListAllContractsBySubject(tx, subjectID)
+		return err
+	})
+	return
+}
+
+func (s *memoryStore) TxListAllContractsBySubject(tx store.SQLTransactional, subjectID string) ([]Contract, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.filter(memoryTx, func(contract Contract) bool {
+		return contract.SubjectID == subjectID
+	}), nil
+}
+
+// TxLockSubjectContracts has nothing to lock, write transactions hold the lock of the whole database
+func (s *memoryStore) TxLockSubjectContracts(tx store.SQLTransactional, subjectID string) error {
+	return nil
+}
+
+func (s *memoryStore) TxListContractsByGroupID(tx store.SQLTransactional, groupID string) ([]Contract, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.filter(memoryTx, func(contract Contract) bool {
+		return contract.GroupID == groupID
+	}), nil
+}
+
+func (s *memoryStore) filter(tx *store.MemoryTx, match func(contract Contract) bool) []Contract {
+	contracts := []Contract{}
+	for _, row := range tx.Scan(contractTable, func(row interface{}) bool {
+		return match(row.(Contract))
+	}) {
+		contracts = append(contracts, row.(Contract))
+	}
+	return contracts
+}
+
+func (s *memoryStore) deleteWhere(tx *store.MemoryTx, match func(contract Contract) bool, notFoundMsg string) error {
+	contracts := s.filter(tx, match)
+	if len(contracts) == 0 {
+		return errors.Error(notFoundMsg)
+	}
+	for _, contract := range contracts {
+		tx.Delete(contractTable, contract.ID)
+	}
+	return nil
+}
+
+func (s *memoryStore) ListContractsByGroupID(ctx context.Context, groupID, afterSubjectID string, limit int) (contracts []Contract, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		if contracts, err = s.TxListContractsByGroupID(tx, groupID); err != nil {
+			return err
+		}
+		contracts = pageBySubjectID(contracts, afterSubjectID, limit)
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *memoryStore) AddBreakGlassDecisions(ctx context.Context, decisions []BreakGlassDecision) error {
+	return s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		for i := range decisions {
+			decisionID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			decisions[i].ID = decisionID.String()
+			memoryTx.Put(decisionTable, decisions[i].ID, decisions[i])
+		}
+		return nil
+	})
+}
+
+func (s *memoryStore) ListBreakGlassDecisions(ctx context.Context, contractID string) (decisions []BreakGlassDecision, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		decisions = []BreakGlassDecision{}
+		for _, row := range memoryTx.Scan(decisionTable, func(row interface{}) bool {
+			return row.(BreakGlassDecision).ContractID == contractID
+		}) {
+			decisions = append(decisions, row.(BreakGlassDecision))
+		}
+		sortDecisions(decisions)
+		return nil
+	})
+	return
+}
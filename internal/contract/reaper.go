@@ -0,0 +1,68 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/dlshle/gommon/logging"
+)
+
+// ExpiryListener is notified of every contract deleted by the reaper
+type ExpiryListener func(ctx context.Context, contract Contract)
+
+// Reaper periodically deletes expired contracts. Expired contracts are already ignored by the authorization
+// path, the reaper only keeps the table small and tells the listeners that a membership ended.
+type Reaper struct {
+	store     Store
+	interval  time.Duration
+	listeners []ExpiryListener
+	logger    logging.Logger
+}
+
+func NewReaper(store Store, interval time.Duration) *Reaper {
+	r := &Reaper{store: store, interval: interval, logger: logging.GlobalLogger.WithPrefix("[ContractReaper]")}
+	r.OnExpired(r.logExpiry)
+	return r
+}
+
+func (r *Reaper) OnExpired(listener ExpiryListener) {
+	r.listeners = append(r.listeners, listener)
+}
+
+// Run reaps each interval until ctx is done
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Reap(ctx); err != nil {
+			r.logger.Errorf(ctx, "failed to reap expired contracts due to %s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reap deletes the contracts expired by now in one transaction and notifies the listeners once it committed
+func (r *Reaper) Reap(ctx context.Context) error {
+	expired, err := r.store.DeleteExpiredContracts(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, contract := range expired {
+		for _, listener := range r.listeners {
+			listener(ctx, contract)
+		}
+	}
+	return nil
+}
+
+func (r *Reaper) logExpiry(ctx context.Context, contract Contract) {
+	if contract.IsBreakGlass() {
+		r.logger.Warnf(ctx, "BREAK-GLASS contract %s of subject %s in group %s expired at %s", contract.ID, contract.SubjectID, contract.GroupID, contract.ExpiresAt.Format(time.RFC3339))
+		return
+	}
+	r.logger.Infof(ctx, "contract %s of subject %s in group %s expired at %s", contract.ID, contract.SubjectID, contract.GroupID, contract.ExpiresAt.Format(time.RFC3339))
+}
@@ -1,59 +1,121 @@
 package contract
 
 import (
+	"context"
+	"time"
+
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
 	"github.com/dlshle/gommon/errors"
 	"github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
-	"google.golang.org/protobuf/proto"
 )
 
 type Store interface {
-	AddNewContract(subjectID, groupID string) (*pb.Contract, error)
-	TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string) (*pb.Contract, error)
+	AddNewContract(subjectID, groupID string, window Window) (*pb.Contract, error)
+	TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string, window Window) (*pb.Contract, error)
+	// TxAddBreakGlassContract adds a contract that records why and by whom it was granted in an emergency
+	TxAddBreakGlassContract(tx store.SQLTransactional, subjectID, groupID string, window Window, breakGlass BreakGlass) (*pb.Contract, error)
+	// UpdateContractWindow fails with FailedPrecondition for break-glass contracts, it joins the transaction
+	// carried by ctx
+	UpdateContractWindow(ctx context.Context, contractID string, window Window) (*pb.Contract, error)
+	// DeleteExpiredContracts deletes the contracts that expired at or before now in one transaction and returns them
+	DeleteExpiredContracts(ctx context.Context, now time.Time) ([]Contract, error)
 	DeleteContractByContractID(contractID string) error
 	DeleteContract(subjectID, groupID string) error
 	TxDeleteContract(tx store.SQLTransactional, contractID string) error
 	TxDeleteContractsByGroupID(tx store.SQLTransactional, groupID string) error
 	ListAllContractsBySubject(subjectID string) ([]Contract, error)
-	ListGroupsBySubjectID(subjectID string) ([]*pb.Group, error)
+	// ListMembershipsBySubjectID only returns groups of contracts in effect, it may read from a read replica when the store has any
+	ListMembershipsBySubjectID(ctx context.Context, subjectID string) ([]Membership, error)
 	TxListAllContractsBySubject(tx store.SQLTransactional, subjectID string) ([]Contract, error)
+	// TxLockSubjectContracts makes transactions that lock the contracts of the same subject wait for tx
+	TxLockSubjectContracts(tx store.SQLTransactional, subjectID string) error
 	TxListContractsByGroupID(tx store.SQLTransactional, groupID string) ([]Contract, error)
+	// ListContractsByGroupID returns up to limit contracts of the group ordered by subject id, starting after
+	// afterSubjectID, it may read from a read replica when the store has any
+	ListContractsByGroupID(ctx context.Context, groupID, afterSubjectID string, limit int) ([]Contract, error)
+	WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error
+	// AddBreakGlassDecisions assigns the ids of decisions and writes them to the primary
+	AddBreakGlassDecisions(ctx context.Context, decisions []BreakGlassDecision) error
+	// ListBreakGlassDecisions returns the decisions made with the contract in the order they were made
+	ListBreakGlassDecisions(ctx context.Context, contractID string) ([]BreakGlassDecision, error)
 }
 
 type contractStore struct {
-	db *sqlx.DB
+	db       *sqlx.DB
+	replicas *store.ReplicaSet
 }
 
 func NewContractStore(db *sqlx.DB) Store {
 	return &contractStore{db: db}
 }
 
-func (s *contractStore) AddNewContract(subjectID, groupID string) (*pb.Contract, error) {
-	return s.TxAddNewContract(s.db, subjectID, groupID)
+// NewReplicatedContractStore writes to the primary of replicas and serves ListMembershipsBySubjectID from its read replicas
+func NewReplicatedContractStore(replicas *store.ReplicaSet) Store {
+	return &contractStore{db: replicas.Primary(), replicas: replicas}
+}
+
+func (s *contractStore) AddNewContract(subjectID, groupID string, window Window) (*pb.Contract, error) {
+	return s.TxAddNewContract(s.db, subjectID, groupID, window)
+}
+
+func (s *contractStore) TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string, window Window) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window})
 }
 
-func (s *contractStore) TxAddNewContract(tx store.SQLTransactional, subjectID, groupID string) (*pb.Contract, error) {
-	contracts, err := s.ListAllContractsBySubject(subjectID)
+func (s *contractStore) TxAddBreakGlassContract(tx store.SQLTransactional, subjectID, groupID string, window Window, breakGlass BreakGlass) (*pb.Contract, error) {
+	return s.txInsert(tx, Contract{SubjectID: subjectID, GroupID: groupID, Window: window, BreakGlass: breakGlass})
+}
+
+func (s *contractStore) txInsert(tx store.SQLTransactional, contract Contract) (*pb.Contract, error) {
+	contractID, err := uuid.NewV4()
 	if err != nil {
 		return nil, err
 	}
-	for _, c := range contracts {
-		if c.GroupID == groupID {
-			return nil, errors.Error("contract by " + subjectID + ":" + groupID + " already exists")
-		}
+	contract.ID = contractID.String()
+	// duplicates and dangling references are rejected by the schema constraints
+	res, err := tx.Exec("INSERT INTO contracts (id, subject_id, group_id, not_before, expires_at, break_glass_justification, break_glass_granted_by) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		contract.ID, contract.SubjectID, contract.GroupID, contract.NotBefore, contract.ExpiresAt, contract.Justification, contract.GrantedBy)
+	if err != nil {
+		return nil, store.TranslateError(err, "contract by "+contract.SubjectID+":"+contract.GroupID)
 	}
-	contractID, err := uuid.NewV4()
+	return contract.ToPB(), store.CheckErrorForRowsAffected(res, "no record is inserted for "+contract.SubjectID+":"+contract.GroupID)
+}
+
+func (s *contractStore) UpdateContractWindow(ctx context.Context, contractID string, window Window) (*pb.Contract, error) {
+	contracts := []Contract{}
+	err := store.WithSQLXTxContext(ctx, s.db, func(tx store.SQLTransactional) error {
+		if err := tx.Select(&contracts, "SELECT * FROM contracts WHERE id = $1", contractID); err != nil {
+			return err
+		}
+		if len(contracts) == 0 {
+			return errors.Error("no record is found for contractID " + contractID)
+		}
+		if err := checkWindowChange(contracts[0]); err != nil {
+			return err
+		}
+		_, err := tx.Exec("UPDATE contracts SET not_before = $2, expires_at = $3 WHERE id = $1", contractID, window.NotBefore, window.ExpiresAt)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	res, err := tx.Exec("INSERT INTO contracts (id, subject_id, group_id) VALUES ($1, $2, $3)", contractID.String(), subjectID, groupID)
+	contracts[0].Window = window
+	return contracts[0].ToPB(), nil
+}
+
+func (s *contractStore) DeleteExpiredContracts(ctx context.Context, now time.Time) ([]Contract, error) {
+	expired := []Contract{}
+	err := store.WithSQLXTxContext(ctx, s.db, func(tx store.SQLTransactional) error {
+		expired = expired[:0]
+		return tx.Select(&expired, "DELETE FROM contracts WHERE expires_at <= $1 RETURNING *", now.UTC())
+	})
 	if err != nil {
 		return nil, err
 	}
-	newContract := &pb.Contract{Id: contractID.String(), SubjectId: subjectID, GroupId: groupID}
-	return newContract, store.CheckErrorForRowsAffected(res, "no record is inserted for "+subjectID+":"+groupID)
+	return expired, nil
 }
 
 func (s *contractStore) DeleteContract(subjectID, groupID string) error {
@@ -84,22 +146,33 @@ func (s *contractStore) TxDeleteContract(tx store.SQLTransactional, contractID s
 	return store.CheckErrorForRowsAffected(res, "no record is found for contractID "+contractID)
 }
 
-func (s *contractStore) ListGroupsBySubjectID(subjectID string) ([]*pb.Group, error) {
-	pbEntities := []store.PBEntity{}
-	err := s.db.Select(&pbEntities, "SELECT * FROM groups WHERE id IN (SELECT group_id FROM contracts WHERE subject_id = $1)", subjectID)
+func (s *contractStore) ListMembershipsBySubjectID(ctx context.Context, subjectID string) ([]Membership, error) {
+	var reader store.SQLTransactional = s.db
+	if s.replicas != nil {
+		reader = s.replicas.Reader(ctx)
+	}
+	rows := []struct {
+		store.PBEntity
+		ContractID string `db:"contract_id"`
+		BreakGlass
+	}{}
+	err := reader.Select(&rows, "SELECT groups.id, groups.payload, groups.document, groups.version, contracts.id AS contract_id, contracts.break_glass_justification, contracts.break_glass_granted_by"+
+		" FROM contracts JOIN groups ON groups.id = contracts.group_id WHERE contracts.subject_id = $1"+
+		" AND (contracts.not_before IS NULL OR contracts.not_before <= $2) AND (contracts.expires_at IS NULL OR contracts.expires_at > $2)", subjectID, time.Now().UTC())
 	if err != nil {
 		return nil, err
 	}
-	groups := make([]*pb.Group, len(pbEntities), len(pbEntities))
-	for i, pbEntity := range pbEntities {
-		pbGroup := &pb.Group{}
-		err = proto.Unmarshal(pbEntity.Payload, pbGroup)
-		if err != nil {
+	memberships := make([]Membership, len(rows), len(rows))
+	for i := range rows {
+		memberships[i].Group = &pb.Group{}
+		if err = store.DecodePBEntity(&rows[i].PBEntity, memberships[i].Group); err != nil {
 			return nil, err
 		}
-		groups[i] = pbGroup
+		if rows[i].IsBreakGlass() {
+			memberships[i].BreakGlassContractID = rows[i].ContractID
+		}
 	}
-	return groups, nil
+	return memberships, nil
 }
 
 func (s *contractStore) ListAllContractsBySubject(subjectID string) ([]Contract, error) {
@@ -112,8 +185,65 @@ func (s *contractStore) TxListAllContractsBySubject(tx store.SQLTransactional, s
 	return contracts, err
 }
 
+func (s *contractStore) TxLockSubjectContracts(tx store.SQLTransactional, subjectID string) error {
+	// sqlite transactions take the write lock when they begin and already exclude each other
+	if s.db.DriverName() == store.SQLiteDriverName {
+		return nil
+	}
+	ids := []string{}
+	return tx.Select(&ids, "SELECT id FROM subjects WHERE id = $1 FOR UPDATE", subjectID)
+}
+
 func (s *contractStore) TxListContractsByGroupID(tx store.SQLTransactional, groupID string) ([]Contract, error) {
 	contracts := []Contract{}
 	err := tx.Select(&contracts, "SELECT * FROM contracts WHERE group_id = $1", groupID)
 	return contracts, err
 }
+
+func (s *contractStore) ListContractsByGroupID(ctx context.Context, groupID, afterSubjectID string, limit int) ([]Contract, error) {
+	var reader store.SQLTransactional = s.db
+	if s.replicas != nil {
+		reader = s.replicas.Reader(ctx)
+	}
+	contracts := []Contract{}
+	var err error
+	// an empty string is not a valid uuid for postgres to compare with
+	if afterSubjectID == "" {
+		err = reader.Select(&contracts, "SELECT * FROM contracts WHERE group_id = $1 ORDER BY subject_id LIMIT $2", groupID, limit)
+	} else {
+		err = reader.Select(&contracts, "SELECT * FROM contracts WHERE group_id = $1 AND subject_id > $2 ORDER BY subject_id LIMIT $3", groupID, afterSubjectID, limit)
+	}
+	return contracts, err
+}
+
+func (s *contractStore) WithTx(ctx context.Context, cb func(tx store.SQLTransactional) error) error {
+	return store.WithSQLXTxContext(ctx, s.db, cb)
+}
+
+func (s *contractStore) AddBreakGlassDecisions(ctx context.Context, decisions []BreakGlassDecision) error {
+	return store.WithSQLXTxContext(ctx, s.db, func(tx store.SQLTransactional) error {
+		for i := range decisions {
+			decisionID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			decisions[i].ID = decisionID.String()
+			d := decisions[i]
+			_, err = tx.Exec("INSERT INTO break_glass_decisions (id, contract_id, subject_id, check_name, verdict, decided_at) VALUES ($1, $2, $3, $4, $5, $6)",
+				d.ID, d.ContractID, d.SubjectID, d.Check, d.Verdict, d.DecidedAt.UTC())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *contractStore) ListBreakGlassDecisions(ctx context.Context, contractID string) ([]BreakGlassDecision, error) {
+	decisions := []BreakGlassDecision{}
+	err := store.WithSQLXTxContext(ctx, s.db, func(tx store.SQLTransactional) error {
+		decisions = decisions[:0]
+		return tx.Select(&decisions, "SELECT * FROM break_glass_decisions WHERE contract_id = $1 ORDER BY decided_at, id", contractID)
+	})
+	return decisions, err
+}
@@ -1,5 +1,15 @@
 package contract
 
+import (
+	"sort"
+	"time"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
 /*
  * A contract connects a subject with multiple groups
  */
@@ -8,4 +18,121 @@ type Contract struct {
 	ID        string `db:"id"`
 	SubjectID string `db:"subject_id"`
 	GroupID   string `db:"group_id"`
+	Window
+	BreakGlass
+}
+
+// Window bounds when a contract is in effect, nil bounds are open
+type Window struct {
+	NotBefore *time.Time `db:"not_before"`
+	ExpiresAt *time.Time `db:"expires_at"`
+}
+
+// WindowFromPB converts unix seconds where 0 leaves the bound open
+func WindowFromPB(notBefore, expiresAt int64) (Window, error) {
+	window := Window{}
+	if notBefore > 0 {
+		t := time.Unix(notBefore, 0).UTC()
+		window.NotBefore = &t
+	}
+	if expiresAt > 0 {
+		t := time.Unix(expiresAt, 0).UTC()
+		window.ExpiresAt = &t
+	}
+	if window.NotBefore != nil && window.ExpiresAt != nil && !window.ExpiresAt.After(*window.NotBefore) {
+		return window, errors.Error("contract expires before it starts")
+	}
+	return window, nil
+}
+
+// Active tells if the contract is in effect at now
+func (w Window) Active(now time.Time) bool {
+	return (w.NotBefore == nil || !now.Before(*w.NotBefore)) && (w.ExpiresAt == nil || now.Before(*w.ExpiresAt))
+}
+
+// Expired tells if the contract ended before or at now
+func (w Window) Expired(now time.Time) bool {
+	return w.ExpiresAt != nil && !now.Before(*w.ExpiresAt)
+}
+
+// BreakGlass records why an emergency contract was granted without approval and who granted it,
+// regular contracts have no justification
+type BreakGlass struct {
+	Justification *string `db:"break_glass_justification"`
+	GrantedBy     *string `db:"break_glass_granted_by"`
+}
+
+func (b BreakGlass) IsBreakGlass() bool {
+	return b.Justification != nil
+}
+
+// Membership is a group the subject is in through a contract in effect
+type Membership struct {
+	Group *pb.Group
+	// BreakGlassContractID is the id of the contract when it was granted by break-glass
+	BreakGlassContractID string
+}
+
+// BreakGlassDecision records an authorization decision made while the subject held a break-glass contract,
+// it outlives the contract
+type BreakGlassDecision struct {
+	ID         string `db:"id"`
+	ContractID string `db:"contract_id"`
+	SubjectID  string `db:"subject_id"`
+	// Check names what was decided, e.g. the policy or the permission
+	Check     string    `db:"check_name"`
+	Verdict   string    `db:"verdict"`
+	DecidedAt time.Time `db:"decided_at"`
+}
+
+// checkWindowChange rejects changing the window of a break-glass contract, it could outlast the longest
+// break-glass membership. Deleting the contract revokes it early.
+func checkWindowChange(contract Contract) error {
+	if contract.IsBreakGlass() {
+		return status.Error(codes.FailedPrecondition, "the window of break-glass contract "+contract.ID+" can not be changed")
+	}
+	return nil
+}
+
+func (c Contract) ToPB() *pb.Contract {
+	contract := &pb.Contract{Id: c.ID, SubjectId: c.SubjectID, GroupId: c.GroupID}
+	if c.NotBefore != nil {
+		contract.NotBefore = c.NotBefore.Unix()
+	}
+	if c.ExpiresAt != nil {
+		contract.ExpiresAt = c.ExpiresAt.Unix()
+	}
+	if c.IsBreakGlass() {
+		contract.BreakGlass = &pb.BreakGlass{Justification: *c.Justification}
+		if c.GrantedBy != nil {
+			contract.BreakGlass.GrantedBy = *c.GrantedBy
+		}
+	}
+	return contract
+}
+
+// pageBySubjectID orders contracts by subject id and returns up to limit of them after afterSubjectID,
+// like the sql store does
+func pageBySubjectID(contracts []Contract, afterSubjectID string, limit int) []Contract {
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].SubjectID < contracts[j].SubjectID
+	})
+	start := sort.Search(len(contracts), func(i int) bool {
+		return contracts[i].SubjectID > afterSubjectID
+	})
+	contracts = contracts[start:]
+	if len(contracts) > limit {
+		contracts = contracts[:limit]
+	}
+	return contracts
+}
+
+// sortDecisions orders decisions by the time they were made like the sql store does
+func sortDecisions(decisions []BreakGlassDecision) {
+	sort.Slice(decisions, func(i, j int) bool {
+		if !decisions[i].DecidedAt.Equal(decisions[j].DecidedAt) {
+			return decisions[i].DecidedAt.Before(decisions[j].DecidedAt)
+		}
+		return decisions[i].ID < decisions[j].ID
+	})
 }
@@ -0,0 +1,201 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"github.com/golang-jwt/jwt"
+)
+
+type Handler struct {
+	store          Store
+	issuers        map[string]Issuer
+	subjectHandler *subject.Handler
+	logger         logging.Logger
+}
+
+func NewHandler(store Store, issuers []Issuer, subjectHandler *subject.Handler) *Handler {
+	issuerMap := make(map[string]Issuer)
+	for _, issuer := range issuers {
+		issuerMap[issuer.Issuer] = issuer
+	}
+	return &Handler{
+		store:          store,
+		issuers:        issuerMap,
+		subjectHandler: subjectHandler,
+		logger:         logging.GlobalLogger.WithPrefix("[FederationHandler]"),
+	}
+}
+
+// Login resolves the subject linked to the id token, provisioning one if the issuer allows it
+func (h *Handler) Login(ctx context.Context, idToken string) (*pb.FederatedLoginResponse, error) {
+	issuer, claims, err := h.verify(idToken)
+	if err != nil {
+		return nil, err
+	}
+	externalSubject := claims["sub"].(string)
+	link, err := h.store.GetLink(issuer.Issuer, externalSubject)
+	if err != nil {
+		return nil, err
+	}
+	if link != nil {
+		// read from the primary, a lagging replica would make a new subject look deleted
+		pbSubject, err := h.subjectHandler.GetSubjectByID(link.SubjectID)
+		if err == nil {
+			return &pb.FederatedLoginResponse{Subject: pbSubject}, nil
+		}
+		if _, notFound := err.(*store.NotFoundError); !notFound {
+			// the link is only stale when the subject is gone, not when it could not be read
+			return nil, err
+		}
+		// the subject was deleted, drop the stale link and treat it as a first login
+		h.logger.Warnf(ctx, "linked subject %s of %s:%s is gone due to %s", link.SubjectID, issuer.Issuer, externalSubject, err.Error())
+		if err = h.store.DeleteLink(issuer.Issuer, externalSubject); err != nil {
+			return nil, err
+		}
+	}
+	if !issuer.AutoProvision {
+		return nil, errors.Error("no subject is linked to " + issuer.Issuer + ":" + externalSubject)
+	}
+	return h.provision(ctx, issuer, externalSubject, claims)
+}
+
+func (h *Handler) provision(ctx context.Context, issuer Issuer, externalSubject string, claims jwt.MapClaims) (*pb.FederatedLoginResponse, error) {
+	userIDClaim := issuer.UserIDClaim
+	if userIDClaim == "" {
+		userIDClaim = "sub"
+	}
+	userID := claimToString(claims[userIDClaim])
+	if userID == "" {
+		return nil, errors.Error("claim " + userIDClaim + " is missing from id token")
+	}
+	resp, err := h.subjectHandler.AddSubjectWithAttributes(ctx, userID, MapClaims(issuer.ClaimMappings, claims))
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.AddLink(&Link{Issuer: issuer.Issuer, ExternalSubject: externalSubject, SubjectID: resp.Subject.Id, CreatedAt: time.Now()})
+	if err != nil {
+		// most likely a concurrent login provisioned the same identity, undo ours
+		h.logger.Warnf(ctx, "failed to link %s:%s to %s due to %s", issuer.Issuer, externalSubject, resp.Subject.Id, err.Error())
+		if _, deleteErr := h.subjectHandler.DeleteSubject(ctx, resp.Subject.Id); deleteErr != nil {
+			h.logger.Errorf(ctx, "failed to delete unlinked subject %s due to %s", resp.Subject.Id, deleteErr.Error())
+		}
+		return nil, err
+	}
+	h.logger.Infof(ctx, "provisioned subject %s for %s:%s", resp.Subject.Id, issuer.Issuer, externalSubject)
+	return &pb.FederatedLoginResponse{Subject: resp.Subject, Provisioned: true}, nil
+}
+
+func (h *Handler) LinkIdentity(ctx context.Context, subjectID, idToken string) (*pb.FederatedIdentity, error) {
+	issuer, claims, err := h.verify(idToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.subjectHandler.GetSubjectByID(subjectID); err != nil {
+		return nil, err
+	}
+	link := &Link{Issuer: issuer.Issuer, ExternalSubject: claims["sub"].(string), SubjectID: subjectID, CreatedAt: time.Now()}
+	if err = h.store.AddLink(link); err != nil {
+		return nil, err
+	}
+	return linkToPB(*link), nil
+}
+
+func (h *Handler) UnlinkIdentity(ctx context.Context, issuer, externalSubject string) (*pb.EmptyResponse, error) {
+	return &pb.EmptyResponse{}, h.store.DeleteLink(issuer, externalSubject)
+}
+
+func (h *Handler) ListIdentities(ctx context.Context, subjectID string) (*pb.FederatedIdentitiesResponse, error) {
+	links, err := h.store.ListLinksBySubjectID(subjectID)
+	if err != nil {
+		return nil, err
+	}
+	identities := make([]*pb.FederatedIdentity, len(links), len(links))
+	for i, link := range links {
+		identities[i] = linkToPB(link)
+	}
+	return &pb.FederatedIdentitiesResponse{Identities: identities}, nil
+}
+
+// verify checks the signature, issuer, audience and lifetime of an upstream id token
+func (h *Handler) verify(idToken string) (Issuer, jwt.MapClaims, error) {
+	unverified, _, err := new(jwt.Parser).ParseUnverified(idToken, jwt.MapClaims{})
+	if err != nil {
+		return Issuer{}, nil, err
+	}
+	issuerName, _ := unverified.Claims.(jwt.MapClaims)["iss"].(string)
+	issuer, ok := h.issuers[issuerName]
+	if !ok {
+		return Issuer{}, nil, errors.Error("untrusted issuer " + issuerName)
+	}
+	token, err := jwt.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.Error("unexpected signing method " + token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return issuer.Keys.Key(kid)
+	})
+	if err != nil {
+		return Issuer{}, nil, err
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyIssuer(issuer.Issuer, true) {
+		return Issuer{}, nil, errors.Error("issuer mismatch")
+	}
+	if !claims.VerifyAudience(issuer.Audience, true) {
+		return Issuer{}, nil, errors.Error("id token is not issued for audience " + issuer.Audience)
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return Issuer{}, nil, errors.Error("id token has no valid expiry")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return Issuer{}, nil, errors.Error("id token has no sub claim")
+	}
+	return issuer, claims, nil
+}
+
+// MapClaims converts id token claims to group attributes, list claims are joined with commas
+func MapClaims(mappings []ClaimMapping, claims jwt.MapClaims) []*pb.Attribute {
+	attributes := make([]*pb.Attribute, 0, len(mappings))
+	for _, mapping := range mappings {
+		value := claimToString(claims[mapping.Claim])
+		if value == "" {
+			value = mapping.Default
+		}
+		if value == "" {
+			continue
+		}
+		attributes = append(attributes, &pb.Attribute{Key: mapping.Attribute, Value: value})
+	}
+	return attributes
+}
+
+func claimToString(claim interface{}) string {
+	switch value := claim.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			values = append(values, claimToString(v))
+		}
+		sort.Strings(values)
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func linkToPB(link Link) *pb.FederatedIdentity {
+	return &pb.FederatedIdentity{Issuer: link.Issuer, ExternalSubject: link.ExternalSubject, SubjectId: link.SubjectID}
+}
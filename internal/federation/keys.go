@@ -0,0 +1,101 @@
+package federation
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dlshle/authnz/internal/oidc"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// unknown key ids trigger a refetch at most this often
+	jwksMinRefreshInterval = time.Minute
+)
+
+type KeySource interface {
+	Key(kid string) (*rsa.PublicKey, error)
+}
+
+type cachedKeySource struct {
+	load        func() ([]byte, error)
+	name        string
+	keys        map[string]*rsa.PublicKey
+	lastRefresh time.Time
+	mutex       sync.Mutex
+}
+
+// NewURLKeySource fetches the issuer's JWKS over http and refetches it when an unknown key id shows up (key rotation)
+func NewURLKeySource(url string) KeySource {
+	httpClient := &http.Client{Timeout: jwksFetchTimeout}
+	return &cachedKeySource{name: url, load: func() ([]byte, error) {
+		resp, err := httpClient.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Error("unexpected status " + resp.Status + " when fetching " + url)
+		}
+		return io.ReadAll(resp.Body)
+	}}
+}
+
+// NewFileKeySource reads the JWKS from a local file, mostly for tests and air-gapped setups
+func NewFileKeySource(path string) KeySource {
+	return &cachedKeySource{name: path, load: func() ([]byte, error) {
+		return os.ReadFile(path)
+	}}
+}
+
+func (s *cachedKeySource) Key(kid string) (*rsa.PublicKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(s.lastRefresh) < jwksMinRefreshInterval {
+		return nil, errors.Error("unknown key id " + kid + " for " + s.name)
+	}
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, errors.Error("unknown key id " + kid + " for " + s.name)
+}
+
+func (s *cachedKeySource) refresh() error {
+	s.lastRefresh = time.Now()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	jwks := oidc.JWKS{}
+	if err = json.Unmarshal(data, &jwks); err != nil {
+		return err
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			logging.GlobalLogger.Warnf(context.Background(), "skip key %s from %s due to %s", jwk.Kid, s.name, err.Error())
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	s.keys = keys
+	return nil
+}
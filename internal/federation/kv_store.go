@@ -0,0 +1,124 @@
+package federation
+
+import (
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+)
+
+const linkSubjectIDIndex = "federated_identities_subject_id_idx"
+
+type kvStore struct {
+	db *store.KVDB
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	db.RegisterCascade("subjects", func(tx *store.KVTx, subjectID string) error {
+		keys, err := tx.Lookup(linkSubjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err = tx.RemoveIndex(linkSubjectIDIndex, subjectID, key); err != nil {
+				return err
+			}
+			if _, err = tx.Delete(linkTable, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return &kvStore{db: db}
+}
+
+func (s *kvStore) GetLink(issuer, externalSubject string) (link *Link, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		found := &Link{}
+		ok, err := kvTx.Get(linkTable, linkKey(issuer, externalSubject), found)
+		if ok {
+			link = found
+		}
+		return err
+	})
+	return
+}
+
+func (s *kvStore) AddLink(link *Link) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		key := linkKey(link.Issuer, link.ExternalSubject)
+		existing, err := kvTx.GetRaw(linkTable, key)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return &store.DuplicateError{Constraint: "federated_identities_pkey", Msg: "federated identity " + link.Issuer + ":" + link.ExternalSubject + " already exists"}
+		}
+		subject, err := kvTx.GetRaw("subjects", link.SubjectID)
+		if err != nil {
+			return err
+		}
+		if subject == nil {
+			return &store.ReferenceError{Constraint: "federated_identities_subject_id_fkey", Msg: "federated identity " + link.Issuer + ":" + link.ExternalSubject + " references a missing record"}
+		}
+		if err = kvTx.Put(linkTable, key, link); err != nil {
+			return err
+		}
+		return kvTx.AddIndex(linkSubjectIDIndex, link.SubjectID, key)
+	})
+}
+
+func (s *kvStore) DeleteLink(issuer, externalSubject string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		key := linkKey(issuer, externalSubject)
+		link := Link{}
+		found, err := kvTx.Get(linkTable, key, &link)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errors.Error("no federated identity found for " + issuer + ":" + externalSubject)
+		}
+		if err = kvTx.RemoveIndex(linkSubjectIDIndex, link.SubjectID, key); err != nil {
+			return err
+		}
+		_, err = kvTx.Delete(linkTable, key)
+		return err
+	})
+}
+
+func (s *kvStore) ListLinksBySubjectID(subjectID string) (links []Link, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		keys, err := kvTx.Lookup(linkSubjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		links = []Link{}
+		for _, key := range keys {
+			link := Link{}
+			found, err := kvTx.Get(linkTable, key, &link)
+			if err != nil {
+				return err
+			}
+			if found {
+				links = append(links, link)
+			}
+		}
+		return nil
+	})
+	return
+}
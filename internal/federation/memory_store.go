@@ -0,0 +1,86 @@
+package federation
+
+import (
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+)
+
+const linkTable = "federated_identities"
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	db.RegisterCascade("subjects", linkTable, func(row interface{}) string {
+		return row.(Link).SubjectID
+	})
+	return &memoryStore{db: db}
+}
+
+func linkKey(issuer, externalSubject string) string {
+	return issuer + "\x00" + externalSubject
+}
+
+func (s *memoryStore) GetLink(issuer, externalSubject string) (link *Link, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		if row, ok := memoryTx.Get(linkTable, linkKey(issuer, externalSubject)); ok {
+			found := row.(Link)
+			link = &found
+		}
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) AddLink(link *Link) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		key := linkKey(link.Issuer, link.ExternalSubject)
+		if _, ok := memoryTx.Get(linkTable, key); ok {
+			return &store.DuplicateError{Constraint: "federated_identities_pkey", Msg: "federated identity " + link.Issuer + ":" + link.ExternalSubject + " already exists"}
+		}
+		if _, ok := memoryTx.Get("subjects", link.SubjectID); !ok {
+			return &store.ReferenceError{Constraint: "federated_identities_subject_id_fkey", Msg: "federated identity " + link.Issuer + ":" + link.ExternalSubject + " references a missing record"}
+		}
+		memoryTx.Put(linkTable, key, *link)
+		return nil
+	})
+}
+
+func (s *memoryStore) DeleteLink(issuer, externalSubject string) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		if !memoryTx.Delete(linkTable, linkKey(issuer, externalSubject)) {
+			return errors.Error("no federated identity found for " + issuer + ":" + externalSubject)
+		}
+		return nil
+	})
+}
+
+func (s *memoryStore) ListLinksBySubjectID(subjectID string) (links []Link, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		links = []Link{}
+		for _, row := range memoryTx.Scan(linkTable, func(row interface{}) bool {
+			return row.(Link).SubjectID == subjectID
+		}) {
+			links = append(links, row.(Link))
+		}
+		return nil
+	})
+	return
+}
@@ -0,0 +1,56 @@
+package federation
+
+import (
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	GetLink(issuer, externalSubject string) (*Link, error)
+	AddLink(link *Link) error
+	DeleteLink(issuer, externalSubject string) error
+	ListLinksBySubjectID(subjectID string) ([]Link, error)
+}
+
+type sqlStore struct {
+	db *sqlx.DB
+}
+
+func NewSQLStore(db *sqlx.DB) Store {
+	return &sqlStore{db: db}
+}
+
+func (s *sqlStore) GetLink(issuer, externalSubject string) (*Link, error) {
+	links := []Link{}
+	err := s.db.Select(&links, "SELECT * FROM federated_identities WHERE issuer = $1 AND external_subject = $2", issuer, externalSubject)
+	if err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+	return &links[0], nil
+}
+
+func (s *sqlStore) AddLink(link *Link) error {
+	res, err := s.db.Exec("INSERT INTO federated_identities (issuer, external_subject, subject_id, created_at) VALUES ($1, $2, $3, $4)",
+		link.Issuer, link.ExternalSubject, link.SubjectID, link.CreatedAt)
+	if err != nil {
+		return store.TranslateError(err, "federated identity "+link.Issuer+":"+link.ExternalSubject)
+	}
+	return store.CheckErrorForRowsAffected(res, "federated identity is not linked for "+link.Issuer+":"+link.ExternalSubject)
+}
+
+func (s *sqlStore) DeleteLink(issuer, externalSubject string) error {
+	res, err := s.db.Exec("DELETE FROM federated_identities WHERE issuer = $1 AND external_subject = $2", issuer, externalSubject)
+	if err != nil {
+		return err
+	}
+	return store.CheckErrorForRowsAffected(res, "no federated identity found for "+issuer+":"+externalSubject)
+}
+
+func (s *sqlStore) ListLinksBySubjectID(subjectID string) ([]Link, error) {
+	links := []Link{}
+	err := s.db.Select(&links, "SELECT * FROM federated_identities WHERE subject_id = $1", subjectID)
+	return links, err
+}
@@ -0,0 +1,31 @@
+package federation
+
+import "time"
+
+/*
+ * A link maps an identity of an upstream issuer (iss + sub) onto an authnz subject.
+ */
+
+type Link struct {
+	Issuer          string    `db:"issuer"`
+	ExternalSubject string    `db:"external_subject"`
+	SubjectID       string    `db:"subject_id"`
+	CreatedAt       time.Time `db:"created_at"`
+}
+
+type ClaimMapping struct {
+	Claim     string
+	Attribute string
+	// used when the claim is absent, the attribute is skipped when both are empty
+	Default string
+}
+
+type Issuer struct {
+	Issuer        string
+	Audience      string
+	Keys          KeySource
+	AutoProvision bool
+	// claim used as the user id of provisioned subjects, defaults to sub
+	UserIDClaim   string
+	ClaimMappings []ClaimMapping
+}
@@ -3,10 +3,13 @@ package group
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/dlshle/authnz/internal/contract"
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 type Handler struct {
@@ -19,7 +22,7 @@ func NewHandler(store Store, contractStore contract.Store) *Handler {
 }
 
 func (h *Handler) CreateGroup(ctx context.Context, group *pb.Group) (*pb.GroupResponse, error) {
-	newGroup, err := h.store.Put(group)
+	newGroup, err := h.put(ctx, group)
 	if err != nil {
 		return nil, err
 	}
@@ -27,34 +30,46 @@ func (h *Handler) CreateGroup(ctx context.Context, group *pb.Group) (*pb.GroupRe
 }
 
 func (h *Handler) UpdateGroup(ctx context.Context, group *pb.Group) (*pb.GroupResponse, error) {
-	updatedGroup, err := h.store.Put(group)
+	updatedGroup, err := h.put(ctx, group)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GroupResponse{Group: updatedGroup}, nil
 }
 
+func (h *Handler) put(ctx context.Context, group *pb.Group) (ret *pb.Group, err error) {
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		// TxPut sets the id and version of the group it is given, a retried transaction starts from the
+		// caller's group again
+		attempt := proto.Clone(group).(*pb.Group)
+		if err := checkParents(tx, h.store, attempt); err != nil {
+			return err
+		}
+		ret, err = h.store.TxPut(tx, attempt)
+		return err
+	})
+	return
+}
+
 func (h *Handler) DuplicateGroup(ctx context.Context, groupID string) (*pb.GroupResponse, error) {
 	existingGroup, err := h.store.Get(groupID)
 	if err != nil {
 		return nil, err
 	}
 	existingGroup.Id = ""
+	existingGroup.Version = 0
 	newGroup, err := h.store.Put(existingGroup)
 	return &pb.GroupResponse{Group: newGroup}, err
 }
 
 func (h *Handler) DeleteGroup(ctx context.Context, groupID string) (*pb.EmptyResponse, error) {
-	var (
-		err error
-	)
-	h.store.WithTx(func(s store.SQLTransactional) error {
-		if _, err = h.store.TxGet(s, groupID); err != nil {
+	err := h.store.WithTx(ctx, func(s store.SQLTransactional) error {
+		if _, err := h.store.TxGet(s, groupID); err != nil {
 			// check if group exists
 			return err
 		}
 		// delete all contracts by group id
-		err = h.contractStore.TxDeleteContractsByGroupID(s, groupID)
+		err := h.contractStore.TxDeleteContractsByGroupID(s, groupID)
 		if err != nil && !strings.HasPrefix(err.Error(), "not found") {
 			return err
 		}
@@ -73,17 +88,140 @@ func (h *Handler) GetGroupsBySubjectID(ctx context.Context, subjectID string) ([
 		groups    []*pb.Group
 		err       error
 	)
-	h.store.WithTx(func(tx store.SQLTransactional) error {
+	err = h.store.View(ctx, func(tx store.SQLTransactional) error {
 		contracts, err = h.contractStore.TxListAllContractsBySubject(tx, subjectID)
 		if err != nil {
 			return err
 		}
-		groupIDs := make([]string, len(contracts), len(contracts))
-		for i, contract := range contracts {
-			groupIDs[i] = contract.GroupID
+		now := time.Now()
+		groupIDs := make([]string, 0, len(contracts))
+		for _, contract := range contracts {
+			if contract.Active(now) {
+				groupIDs = append(groupIDs, contract.GroupID)
+			}
 		}
 		groups, err = h.store.TxBulkGet(tx, groupIDs)
 		return err
 	})
 	return groups, err
 }
+
+// ListGroupsByAttributes returns the groups having all of the attributes themselves, inherited attributes
+// are not considered
+func (h *Handler) ListGroupsByAttributes(ctx context.Context, attributes []*pb.Attribute) ([]*pb.Group, error) {
+	if len(attributes) == 0 {
+		return nil, errors.Error("at least one attribute is required")
+	}
+	return h.store.ListByAttributes(attributes)
+}
+
+func (h *Handler) AttachParent(ctx context.Context, groupID, parentID string) (*pb.GroupResponse, error) {
+	return h.update(ctx, groupID, func(tx store.SQLTransactional, group *pb.Group) error {
+		group.ParentIds = addID(group.ParentIds, parentID)
+		return checkParents(tx, h.store, group)
+	})
+}
+
+func (h *Handler) DetachParent(ctx context.Context, groupID, parentID string) (*pb.GroupResponse, error) {
+	return h.update(ctx, groupID, func(tx store.SQLTransactional, group *pb.Group) error {
+		group.ParentIds = removeID(group.ParentIds, parentID)
+		return nil
+	})
+}
+
+// AssignRole grants the role to the group, the caller makes sure that the role exists
+func (h *Handler) AssignRole(ctx context.Context, groupID, roleID string) (*pb.GroupResponse, error) {
+	return h.update(ctx, groupID, func(tx store.SQLTransactional, group *pb.Group) error {
+		group.RoleIds = addID(group.RoleIds, roleID)
+		return nil
+	})
+}
+
+func (h *Handler) UnassignRole(ctx context.Context, groupID, roleID string) (*pb.GroupResponse, error) {
+	return h.update(ctx, groupID, func(tx store.SQLTransactional, group *pb.Group) error {
+		group.RoleIds = removeID(group.RoleIds, roleID)
+		return nil
+	})
+}
+
+func (h *Handler) update(ctx context.Context, groupID string, mutate func(tx store.SQLTransactional, group *pb.Group) error) (*pb.GroupResponse, error) {
+	var group *pb.Group
+	err := h.store.WithTx(ctx, func(tx store.SQLTransactional) (err error) {
+		if group, err = h.store.TxGet(tx, groupID); err != nil {
+			return err
+		}
+		if err = mutate(tx, group); err != nil {
+			return err
+		}
+		group, err = h.store.TxPut(tx, group)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupResponse{Group: group}, nil
+}
+
+func addID(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func removeID(ids []string, id string) []string {
+	remaining := make([]string, 0, len(ids))
+	for _, existing := range ids {
+		if existing != id {
+			remaining = append(remaining, existing)
+		}
+	}
+	return remaining
+}
+
+// GetEffectiveGroup returns the group with its inherited attributes and roles merged into its own
+func (h *Handler) GetEffectiveGroup(ctx context.Context, groupID string) (*pb.GroupResponse, error) {
+	group, err := h.store.Get(groupID)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := h.ResolveInheritance(ctx, []*pb.Group{group})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupResponse{Group: resolved[0]}, nil
+}
+
+// ResolveInheritance returns copies of groups whose attributes and roles include the ones they inherit
+func (h *Handler) ResolveInheritance(ctx context.Context, groups []*pb.Group) ([]*pb.Group, error) {
+	hasParents := false
+	for _, group := range groups {
+		hasParents = hasParents || len(group.ParentIds) > 0
+	}
+	if !hasParents {
+		return groups, nil
+	}
+	var loaded map[string]*pb.Group
+	err := h.store.View(ctx, func(tx store.SQLTransactional) (err error) {
+		loaded, err = loadAncestors(tx, h.store.TxBulkGet, groups)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := newResolver(loaded)
+	resolved := make([]*pb.Group, len(groups), len(groups))
+	for i, group := range groups {
+		resolved[i] = &pb.Group{
+			Id:               group.Id,
+			Attributes:       r.effectiveAttributes(group.Id),
+			Version:          group.Version,
+			ParentIds:        group.ParentIds,
+			RoleIds:          r.effectiveRoleIDs(group.Id),
+			ApprovalPolicyId: group.ApprovalPolicyId,
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,235 @@
+package group
+
+import (
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CycleError is returned when a parent would make a group its own ancestor
+type CycleError struct {
+	Msg string
+}
+
+func (e *CycleError) Error() string {
+	return e.Msg
+}
+
+func (e *CycleError) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Msg)
+}
+
+// loadAncestors returns groups and all of their ancestors, read with bulkGet, keyed by id. Parents that no longer
+// exist, e.g. because they were deleted, map to nil and are ignored by the resolution.
+func loadAncestors(tx store.SQLTransactional, bulkGet func(store.SQLTransactional, []string) ([]*pb.Group, error), groups []*pb.Group) (map[string]*pb.Group, error) {
+	loaded := make(map[string]*pb.Group)
+	for _, group := range groups {
+		loaded[group.Id] = group
+	}
+	pending := unloadedParentIDs(groups, loaded)
+	for len(pending) > 0 {
+		parents, err := bulkGet(tx, pending)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			loaded[parent.Id] = parent
+		}
+		for _, id := range pending {
+			if _, ok := loaded[id]; !ok {
+				loaded[id] = nil
+			}
+		}
+		pending = unloadedParentIDs(parents, loaded)
+	}
+	return loaded, nil
+}
+
+// Lineage is a group with the ids of all of its ancestors and its own and inherited attributes
+type Lineage struct {
+	Group       *pb.Group
+	AncestorIDs []string
+	Attributes  []*pb.Attribute
+}
+
+// TxLineages reads the ancestors of groups in tx and returns the lineage of each group in order
+func TxLineages(tx store.SQLTransactional, groupStore Store, groups []*pb.Group) ([]*Lineage, error) {
+	loaded, err := loadAncestors(tx, groupStore.TxBulkGet, groups)
+	if err != nil {
+		return nil, err
+	}
+	r := newResolver(loaded)
+	lineages := make([]*Lineage, len(groups), len(groups))
+	for i, group := range groups {
+		lineages[i] = &Lineage{Group: group, AncestorIDs: r.ancestorIDs(group.Id), Attributes: r.effectiveAttributes(group.Id)}
+	}
+	return lineages, nil
+}
+
+func unloadedParentIDs(groups []*pb.Group, loaded map[string]*pb.Group) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		for _, parentID := range group.ParentIds {
+			if _, ok := loaded[parentID]; !ok && !seen[parentID] {
+				seen[parentID] = true
+				ids = append(ids, parentID)
+			}
+		}
+	}
+	return ids
+}
+
+// checkParents rejects missing parents and parents that descend from group. The group and the ancestors of its
+// parents are locked as they are read, so that two transactions giving each other's groups as parents wait for
+// one another instead of both missing the cycle.
+func checkParents(tx store.SQLTransactional, groupStore Store, group *pb.Group) error {
+	if len(group.ParentIds) == 0 {
+		return nil
+	}
+	if group.Id != "" {
+		if _, err := groupStore.TxBulkGetForUpdate(tx, []string{group.Id}); err != nil {
+			return err
+		}
+	}
+	parents, err := groupStore.TxBulkGetForUpdate(tx, group.ParentIds)
+	if err != nil {
+		return err
+	}
+	found := make(map[string]bool)
+	for _, parent := range parents {
+		found[parent.Id] = true
+	}
+	for _, parentID := range group.ParentIds {
+		if parentID == group.Id {
+			return &CycleError{Msg: "group " + group.Id + " can not be its own parent"}
+		}
+		if !found[parentID] {
+			return &store.ReferenceError{Constraint: "groups_parent_ids", Msg: "parent group " + parentID + " is not found"}
+		}
+	}
+	if group.Id == "" {
+		// a new group has no descendants
+		return nil
+	}
+	ancestors, err := loadAncestors(tx, groupStore.TxBulkGetForUpdate, parents)
+	if err != nil {
+		return err
+	}
+	if _, ok := ancestors[group.Id]; ok {
+		return &CycleError{Msg: "group " + group.Id + " is already an ancestor of its parents"}
+	}
+	return nil
+}
+
+// resolver computes the effective attributes and roles of loaded groups: inherited attributes come first so
+// that, like in MergeGroups and FromPB, the closer definition wins
+type resolver struct {
+	loaded            map[string]*pb.Group
+	resolved          map[string][]*pb.Attribute
+	resolvedRoles     map[string][]string
+	resolvedAncestors map[string][]string
+	visiting          map[string]bool
+}
+
+func newResolver(loaded map[string]*pb.Group) *resolver {
+	return &resolver{
+		loaded:            loaded,
+		resolved:          make(map[string][]*pb.Attribute),
+		resolvedRoles:     make(map[string][]string),
+		resolvedAncestors: make(map[string][]string),
+		visiting:          make(map[string]bool),
+	}
+}
+
+func (r *resolver) effectiveAttributes(groupID string) []*pb.Attribute {
+	if attributes, ok := r.resolved[groupID]; ok {
+		return attributes
+	}
+	group := r.loaded[groupID]
+	// cycles can only appear through concurrent writes, they are cut at the group that closes them
+	if group == nil || r.visiting[groupID] {
+		return nil
+	}
+	r.visiting[groupID] = true
+	var attributes []*pb.Attribute
+	for _, parentID := range group.ParentIds {
+		attributes = append(attributes, r.effectiveAttributes(parentID)...)
+	}
+	delete(r.visiting, groupID)
+	attributes = dedupeAttributes(append(attributes, group.Attributes...))
+	r.resolved[groupID] = attributes
+	return attributes
+}
+
+func (r *resolver) effectiveRoleIDs(groupID string) []string {
+	if roleIDs, ok := r.resolvedRoles[groupID]; ok {
+		return roleIDs
+	}
+	group := r.loaded[groupID]
+	if group == nil || r.visiting[groupID] {
+		return nil
+	}
+	r.visiting[groupID] = true
+	var roleIDs []string
+	for _, parentID := range group.ParentIds {
+		roleIDs = append(roleIDs, r.effectiveRoleIDs(parentID)...)
+	}
+	delete(r.visiting, groupID)
+	roleIDs = dedupeIDs(append(roleIDs, group.RoleIds...))
+	r.resolvedRoles[groupID] = roleIDs
+	return roleIDs
+}
+
+// ancestorIDs returns the ids of the existing ancestors of the group, closer ones first
+func (r *resolver) ancestorIDs(groupID string) []string {
+	if ancestorIDs, ok := r.resolvedAncestors[groupID]; ok {
+		return ancestorIDs
+	}
+	group := r.loaded[groupID]
+	if group == nil || r.visiting[groupID] {
+		return nil
+	}
+	r.visiting[groupID] = true
+	var ancestorIDs []string
+	for _, parentID := range group.ParentIds {
+		if r.loaded[parentID] != nil && parentID != groupID {
+			ancestorIDs = append(ancestorIDs, parentID)
+		}
+	}
+	for _, parentID := range group.ParentIds {
+		ancestorIDs = append(ancestorIDs, r.ancestorIDs(parentID)...)
+	}
+	delete(r.visiting, groupID)
+	ancestorIDs = dedupeIDs(ancestorIDs)
+	r.resolvedAncestors[groupID] = ancestorIDs
+	return ancestorIDs
+}
+
+func dedupeIDs(ids []string) []string {
+	seen := make(map[string]bool)
+	deduped := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			deduped = append(deduped, id)
+		}
+	}
+	return deduped
+}
+
+// dedupeAttributes keeps the last value of every key at the position the key first appeared
+func dedupeAttributes(attributes []*pb.Attribute) []*pb.Attribute {
+	index := make(map[string]int)
+	deduped := make([]*pb.Attribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		if i, ok := index[attribute.Key]; ok {
+			deduped[i] = attribute
+			continue
+		}
+		index[attribute.Key] = len(deduped)
+		deduped = append(deduped, attribute)
+	}
+	return deduped
+}
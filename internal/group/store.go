@@ -1,62 +1,91 @@
 package group
 
 import (
+	"context"
+
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
 	"github.com/dlshle/gommon/utils"
 	"github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
-	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type Store interface {
 	Get(id string) (*pb.Group, error)
 	TxGet(tx store.SQLTransactional, id string) (*pb.Group, error)
 	TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Group, error)
+	// TxBulkGetForUpdate also locks the groups until tx ends
+	TxBulkGetForUpdate(tx store.SQLTransactional, ids []string) ([]*pb.Group, error)
 	Delete(id string) error
 	TxDelete(tx store.SQLTransactional, groupID string) error
 	Put(group *pb.Group) (*pb.Group, error)
 	TxPut(tx store.SQLTransactional, group *pb.Group) (*pb.Group, error)
-	WithTx(func(store.SQLTransactional) error) error
+	WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error
+	// View runs cb with a handle for reads that tolerate replication lag, or in the transaction carried by ctx
+	View(ctx context.Context, cb func(store.SQLTransactional) error) error
+	// ListByAttributes returns the groups having all of the attributes
+	ListByAttributes(attributes []*pb.Attribute) ([]*pb.Group, error)
 }
 
-type SQLGroupStore struct {
+// PBGroupStore keeps groups in the entity store of the backend it is constructed for
+type PBGroupStore struct {
 	PbEntityStore store.PBEntityStore
+	format        store.PayloadFormat
+}
+
+func NewSQLStore(db *sqlx.DB, format store.PayloadFormat) Store {
+	return &PBGroupStore{PbEntityStore: store.NewSQLPBEntityStore(db, "groups"), format: format}
+}
+
+func NewReplicatedSQLStore(replicas *store.ReplicaSet, format store.PayloadFormat) Store {
+	return &PBGroupStore{PbEntityStore: store.NewReplicatedSQLPBEntityStore(replicas, "groups"), format: format}
 }
 
-func NewSQLStore(db *sqlx.DB) Store {
-	return &SQLGroupStore{PbEntityStore: store.NewSQLPBEntityStore(db, "groups")}
+func NewKVStore(db *store.KVDB) Store {
+	return &PBGroupStore{PbEntityStore: store.NewKVPBEntityStore(db, "groups")}
 }
 
-func (s *SQLGroupStore) Get(id string) (*pb.Group, error) {
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &PBGroupStore{PbEntityStore: store.NewMemoryPBEntityStore(db, "groups")}
+}
+
+func (s *PBGroupStore) Get(id string) (*pb.Group, error) {
 	pbEntity, err := s.PbEntityStore.Get(id)
 	if err != nil {
 		return nil, err
 	}
 	group := &pb.Group{}
-	err = proto.Unmarshal(pbEntity.Payload, group)
+	err = store.DecodePBEntity(pbEntity, group)
 	return group, err
 }
 
-func (s *SQLGroupStore) TxGet(tx store.SQLTransactional, id string) (*pb.Group, error) {
+func (s *PBGroupStore) TxGet(tx store.SQLTransactional, id string) (*pb.Group, error) {
 	pbEntity, err := s.PbEntityStore.TxGet(tx, id)
 	if err != nil {
 		return nil, err
 	}
 	group := &pb.Group{}
-	err = proto.Unmarshal(pbEntity.Payload, group)
+	err = store.DecodePBEntity(pbEntity, group)
 	return group, err
 }
 
-func (s *SQLGroupStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Group, error) {
-	entities, err := s.PbEntityStore.TxBulkGet(tx, ids)
+func (s *PBGroupStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Group, error) {
+	return decodeGroups(s.PbEntityStore.TxBulkGet(tx, ids))
+}
+
+func (s *PBGroupStore) TxBulkGetForUpdate(tx store.SQLTransactional, ids []string) ([]*pb.Group, error) {
+	return decodeGroups(s.PbEntityStore.TxBulkGetForUpdate(tx, ids))
+}
+
+func decodeGroups(entities []*store.PBEntity, err error) ([]*pb.Group, error) {
 	if err != nil {
 		return nil, err
 	}
 	groups := make([]*pb.Group, len(entities), len(entities))
 	for i, entity := range entities {
 		group := &pb.Group{}
-		err = proto.Unmarshal(entity.Payload, group)
+		err = store.DecodePBEntity(entity, group)
 		if err != nil {
 			return nil, err
 		}
@@ -65,9 +94,8 @@ func (s *SQLGroupStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*p
 	return groups, nil
 }
 
-func (s *SQLGroupStore) Put(group *pb.Group) (ret *pb.Group, err error) {
+func (s *PBGroupStore) Put(group *pb.Group) (ret *pb.Group, err error) {
 	var (
-		payload  []byte
 		pbEntity *store.PBEntity
 	)
 	err = utils.ProcessWithErrors(func() error {
@@ -80,21 +108,21 @@ func (s *SQLGroupStore) Put(group *pb.Group) (ret *pb.Group, err error) {
 		}
 		return nil
 	}, func() error {
-		payload, err = proto.Marshal(group)
+		pbEntity, err = store.EncodePBEntity(group.Id, group, s.format)
 		return err
 	}, func() error {
-		pbEntity, err = s.PbEntityStore.Put(&store.PBEntity{ID: group.Id, Payload: payload})
+		pbEntity, err = s.PbEntityStore.Put(pbEntity)
 		return err
 	}, func() error {
 		group.Id = pbEntity.ID
+		group.Version = pbEntity.Version
 		return nil
 	})
 	return group, err
 }
 
-func (s *SQLGroupStore) TxPut(tx store.SQLTransactional, group *pb.Group) (ret *pb.Group, err error) {
+func (s *PBGroupStore) TxPut(tx store.SQLTransactional, group *pb.Group) (ret *pb.Group, err error) {
 	var (
-		payload  []byte
 		pbEntity *store.PBEntity
 	)
 	err = utils.ProcessWithErrors(func() error {
@@ -107,26 +135,70 @@ func (s *SQLGroupStore) TxPut(tx store.SQLTransactional, group *pb.Group) (ret *
 		}
 		return nil
 	}, func() error {
-		payload, err = proto.Marshal(group)
+		pbEntity, err = store.EncodePBEntity(group.Id, group, s.format)
 		return err
 	}, func() error {
-		pbEntity, err = s.PbEntityStore.TxPut(tx, &store.PBEntity{ID: group.Id, Payload: payload})
+		pbEntity, err = s.PbEntityStore.TxPut(tx, pbEntity)
 		return err
 	}, func() error {
 		group.Id = pbEntity.ID
+		group.Version = pbEntity.Version
 		return nil
 	})
 	return group, err
 }
 
-func (s *SQLGroupStore) Delete(id string) error {
+func (s *PBGroupStore) Delete(id string) error {
 	return s.PbEntityStore.Delete(id)
 }
 
-func (s *SQLGroupStore) TxDelete(tx store.SQLTransactional, groupID string) error {
+func (s *PBGroupStore) TxDelete(tx store.SQLTransactional, groupID string) error {
 	return s.PbEntityStore.TxDelete(tx, groupID)
 }
 
-func (s *SQLGroupStore) WithTx(cb func(store.SQLTransactional) error) error {
-	return s.PbEntityStore.WithTx(cb)
+func (s *PBGroupStore) WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.PbEntityStore.WithTx(ctx, cb)
+}
+
+func (s *PBGroupStore) View(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.PbEntityStore.View(ctx, cb)
+}
+
+func (s *PBGroupStore) ListByAttributes(attributes []*pb.Attribute) ([]*pb.Group, error) {
+	containment, err := protojson.Marshal(&pb.Group{Attributes: attributes})
+	if err != nil {
+		return nil, err
+	}
+	entities, err := s.PbEntityStore.FindByDocument(containment)
+	if err != nil {
+		return nil, err
+	}
+	groups := []*pb.Group{}
+	for _, entity := range entities {
+		group := &pb.Group{}
+		if err = store.DecodePBEntity(entity, group); err != nil {
+			return nil, err
+		}
+		// FindByDocument returns the groups written before documents were kept as well
+		if hasAttributes(group, attributes) {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+func hasAttributes(group *pb.Group, attributes []*pb.Attribute) bool {
+	for _, attribute := range attributes {
+		found := false
+		for _, groupAttribute := range group.Attributes {
+			if groupAttribute.Key == attribute.Key && groupAttribute.Value == attribute.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,315 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"github.com/dlshle/gommon/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	defaultIssuer      = "authnz"
+	defaultSessionTTL  = 12 * time.Hour
+)
+
+type Handler struct {
+	store        Store
+	subjectStore subject.Store
+	issuer       string
+	sessionTTL   time.Duration
+	limiter      *attemptLimiter
+	logger       logging.Logger
+}
+
+func NewHandler(store Store, subjectStore subject.Store, issuer string, sessionTTL time.Duration) *Handler {
+	if issuer == "" {
+		issuer = defaultIssuer
+	}
+	if sessionTTL <= 0 {
+		sessionTTL = defaultSessionTTL
+	}
+	return &Handler{
+		store:        store,
+		subjectStore: subjectStore,
+		issuer:       issuer,
+		sessionTTL:   sessionTTL,
+		limiter:      newAttemptLimiter(),
+		logger:       logging.GlobalLogger.WithPrefix("[MFAHandler]"),
+	}
+}
+
+// EnrollTOTP creates the totp secret of a subject, the enrollment has to be confirmed with ConfirmTOTP before it
+// can start mfa sessions. An unconfirmed secret can be replaced freely, replacing a confirmed one needs an mfa
+// session of the subject.
+func (h *Handler) EnrollTOTP(ctx context.Context, subjectID, accountName, mfaSessionID string) (*pb.EnrollTOTPResponse, error) {
+	var (
+		pbSubject     *pb.Subject
+		secret        string
+		recoveryCodes []string
+	)
+	authenticated, err := h.hasSession(subjectID, mfaSessionID)
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		return utils.ProcessWithErrors(func() error {
+			pbSubject, err = h.subjectStore.TxGet(tx, subjectID)
+			return err
+		}, func() error {
+			return h.checkReplaceable(tx, subjectID, authenticated)
+		}, func() error {
+			secret, err = GenerateSecret()
+			return err
+		}, func() error {
+			return h.store.TxPutEnrollment(tx, &Enrollment{SubjectID: subjectID, Secret: secret})
+		}, func() error {
+			recoveryCodes, err = generateRecoveryCodes()
+			return err
+		}, func() error {
+			codeHashes := make([]string, len(recoveryCodes), len(recoveryCodes))
+			for i, code := range recoveryCodes {
+				codeHashes[i] = hashRecoveryCode(code)
+			}
+			return h.store.TxReplaceRecoveryCodes(tx, subjectID, codeHashes)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if accountName == "" {
+		accountName = pbSubject.UserId
+	}
+	h.logger.Infof(ctx, "totp enrolled for subject %s", subjectID)
+	return &pb.EnrollTOTPResponse{
+		Secret:        secret,
+		OtpauthUri:    OTPAuthURI(h.issuer, accountName, secret),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// ConfirmTOTP confirms a new enrollment with a valid code, proving the subject holds the secret
+func (h *Handler) ConfirmTOTP(ctx context.Context, subjectID, code string) (*pb.EmptyResponse, error) {
+	err := h.useCode(ctx, subjectID, code, false, func(tx store.SQLTransactional, now time.Time) error {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Infof(ctx, "totp enrollment confirmed for subject %s", subjectID)
+	return &pb.EmptyResponse{}, nil
+}
+
+// VerifyTOTP starts an mfa session for a valid code of a confirmed enrollment, subjects are locked out for a while
+// after too many invalid codes or recovery codes
+func (h *Handler) VerifyTOTP(ctx context.Context, subjectID, code string) (*pb.MFASessionResponse, error) {
+	var session *Session
+	err := h.useCode(ctx, subjectID, code, true, func(tx store.SQLTransactional, now time.Time) (err error) {
+		session, err = h.store.TxAddSession(tx, h.newSession(subjectID, now))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sessionToPB(session), nil
+}
+
+// useCode consumes a valid code of an enrollment that is in the confirmed state and runs then in the same
+// transaction, an invalid code counts as a failed attempt of the subject
+func (h *Handler) useCode(ctx context.Context, subjectID, code string, confirmed bool, then func(tx store.SQLTransactional, now time.Time) error) error {
+	var invalid bool
+	if err := h.limiter.allow(subjectID, time.Now()); err != nil {
+		return err
+	}
+	err := h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		invalid = false
+		enrollment, err := h.store.TxGetEnrollment(tx, subjectID)
+		if err != nil {
+			return err
+		}
+		if err = checkConfirmed(enrollment, confirmed); err != nil {
+			return err
+		}
+		now := time.Now()
+		step, err := MatchCode(enrollment.Secret, code, now, enrollment.LastUsedStep)
+		if err != nil {
+			return err
+		}
+		if step < 0 {
+			h.logger.Warnf(ctx, "invalid totp code for subject %s", subjectID)
+			invalid = true
+			return errors.Error("invalid totp code")
+		}
+		if err = h.store.TxAdvanceStep(tx, subjectID, step); err != nil {
+			return err
+		}
+		return then(tx, now)
+	})
+	if invalid {
+		h.limiter.fail(subjectID, time.Now())
+	}
+	if err != nil {
+		return err
+	}
+	h.limiter.succeed(subjectID)
+	return nil
+}
+
+// RedeemRecoveryCode consumes a one-time recovery code of a confirmed enrollment in place of a totp code
+func (h *Handler) RedeemRecoveryCode(ctx context.Context, subjectID, recoveryCode string) (*pb.MFASessionResponse, error) {
+	var (
+		session *Session
+		invalid bool
+		err     error
+	)
+	if err = h.limiter.allow(subjectID, time.Now()); err != nil {
+		return nil, err
+	}
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		invalid = false
+		enrollment, err := h.store.TxGetEnrollment(tx, subjectID)
+		if err != nil {
+			return err
+		}
+		if err = checkConfirmed(enrollment, true); err != nil {
+			return err
+		}
+		if err = h.store.TxUseRecoveryCode(tx, subjectID, hashRecoveryCode(recoveryCode)); err != nil {
+			h.logger.Warnf(ctx, "failed to redeem recovery code for subject %s due to %s", subjectID, err.Error())
+			invalid = true
+			return err
+		}
+		session, err = h.store.TxAddSession(tx, h.newSession(subjectID, time.Now()))
+		return err
+	})
+	if invalid {
+		h.limiter.fail(subjectID, time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.limiter.succeed(subjectID)
+	h.logger.Infof(ctx, "recovery code redeemed for subject %s", subjectID)
+	return sessionToPB(session), nil
+}
+
+// DeleteTOTP deletes an unconfirmed enrollment, or a confirmed one with an mfa session of the subject
+func (h *Handler) DeleteTOTP(ctx context.Context, subjectID, mfaSessionID string) (*pb.EmptyResponse, error) {
+	authenticated, err := h.hasSession(subjectID, mfaSessionID)
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.WithTx(ctx, func(tx store.SQLTransactional) error {
+		return utils.ProcessWithErrors(func() error {
+			return h.checkReplaceable(tx, subjectID, authenticated)
+		}, func() error {
+			return h.store.TxDeleteEnrollment(tx, subjectID)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Infof(ctx, "totp enrollment deleted for subject %s", subjectID)
+	return &pb.EmptyResponse{}, nil
+}
+
+// hasSession validates the mfa session of the subject if one is given, sessions are read before the
+// transactions that need them since the embedded stores do not nest transactions
+func (h *Handler) hasSession(subjectID, mfaSessionID string) (bool, error) {
+	if mfaSessionID == "" {
+		return false, nil
+	}
+	if _, err := h.GetAuthenticatedSession(subjectID, mfaSessionID); err != nil {
+		return false, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return true, nil
+}
+
+// checkReplaceable fails when the subject has a confirmed enrollment and has not authenticated with it
+func (h *Handler) checkReplaceable(tx store.SQLTransactional, subjectID string, authenticated bool) error {
+	enrollment, err := h.store.TxGetEnrollment(tx, subjectID)
+	if _, notFound := err.(*store.NotFoundError); notFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if enrollment.Confirmed && !authenticated {
+		return status.Error(codes.PermissionDenied, "an mfa session is required to change the confirmed totp enrollment of subject "+subjectID)
+	}
+	return nil
+}
+
+func checkConfirmed(enrollment *Enrollment, confirmed bool) error {
+	if enrollment.Confirmed == confirmed {
+		return nil
+	}
+	if confirmed {
+		return status.Error(codes.FailedPrecondition, "totp enrollment of subject "+enrollment.SubjectID+" is not confirmed")
+	}
+	return status.Error(codes.FailedPrecondition, "totp enrollment of subject "+enrollment.SubjectID+" is already confirmed")
+}
+
+// AllowAttempt fails while the subject is locked out. Factors checked along with a code, such as passwords, call
+// it before the check and FailAttempt when the check fails, so they share the lockout of the codes.
+func (h *Handler) AllowAttempt(subjectID string) error {
+	return h.limiter.allow(subjectID, time.Now())
+}
+
+func (h *Handler) FailAttempt(subjectID string) {
+	h.limiter.fail(subjectID, time.Now())
+}
+
+// GetAuthenticatedSession returns the mfa session if it belongs to the subject and has not expired
+func (h *Handler) GetAuthenticatedSession(subjectID, sessionID string) (*Session, error) {
+	session, err := h.store.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.SubjectID != subjectID {
+		return nil, errors.Error("mfa session " + sessionID + " does not belong to subject " + subjectID)
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return nil, errors.Error("mfa session " + sessionID + " has expired")
+	}
+	return session, nil
+}
+
+func (h *Handler) newSession(subjectID string, now time.Time) *Session {
+	return &Session{SubjectID: subjectID, AuthenticatedAt: now, ExpiresAt: now.Add(h.sessionTTL)}
+}
+
+func sessionToPB(session *Session) *pb.MFASessionResponse {
+	return &pb.MFASessionResponse{SessionId: session.ID, ExpiresAt: session.ExpiresAt.Unix()}
+}
+
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(base32NoPadding.EncodeToString(raw))[:recoveryCodeLength]
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+	return codes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
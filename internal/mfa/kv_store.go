@@ -0,0 +1,222 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	recoveryCodeSubjectIDIndex = "mfa_recovery_codes_subject_id_idx"
+	sessionSubjectIDIndex      = "mfa_sessions_subject_id_idx"
+)
+
+type kvStore struct {
+	db *store.KVDB
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	s := &kvStore{db: db}
+	db.RegisterCascade("subjects", func(tx *store.KVTx, subjectID string) error {
+		if _, err := tx.Delete(enrollmentTable, subjectID); err != nil {
+			return err
+		}
+		if err := s.deleteRecoveryCodes(tx, subjectID); err != nil {
+			return err
+		}
+		sessionIDs, err := tx.Lookup(sessionSubjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		for _, sessionID := range sessionIDs {
+			if err = tx.RemoveIndex(sessionSubjectIDIndex, subjectID, sessionID); err != nil {
+				return err
+			}
+			if _, err = tx.Delete(sessionTable, sessionID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return s
+}
+
+func (s *kvStore) TxGetEnrollment(tx store.SQLTransactional, subjectID string) (*Enrollment, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	enrollment := &Enrollment{}
+	found, err := kvTx.Get(enrollmentTable, subjectID, enrollment)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &store.NotFoundError{Msg: "no totp enrollment found for " + subjectID}
+	}
+	return enrollment, nil
+}
+
+func (s *kvStore) TxPutEnrollment(tx store.SQLTransactional, enrollment *Enrollment) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	if err = requireSubject(kvTx, enrollment.SubjectID, "mfa_enrollments_subject_id_fkey", "totp enrollment for "+enrollment.SubjectID); err != nil {
+		return err
+	}
+	return kvTx.Put(enrollmentTable, enrollment.SubjectID, enrollment)
+}
+
+func (s *kvStore) TxDeleteEnrollment(tx store.SQLTransactional, subjectID string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	if err = s.deleteRecoveryCodes(kvTx, subjectID); err != nil {
+		return err
+	}
+	deleted, err := kvTx.Delete(enrollmentTable, subjectID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.Error("no totp enrollment found for " + subjectID)
+	}
+	return nil
+}
+
+func (s *kvStore) TxAdvanceStep(tx store.SQLTransactional, subjectID string, step int64) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	enrollment := Enrollment{}
+	found, err := kvTx.Get(enrollmentTable, subjectID, &enrollment)
+	if err != nil {
+		return err
+	}
+	if !found || enrollment.LastUsedStep >= step {
+		return errors.Error("totp code has already been used")
+	}
+	enrollment.LastUsedStep = step
+	enrollment.Confirmed = true
+	return kvTx.Put(enrollmentTable, subjectID, enrollment)
+}
+
+func (s *kvStore) TxReplaceRecoveryCodes(tx store.SQLTransactional, subjectID string, codeHashes []string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	if err = s.deleteRecoveryCodes(kvTx, subjectID); err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		if err = kvTx.Put(recoveryCodeTable, id.String(), RecoveryCode{ID: id.String(), SubjectID: subjectID, CodeHash: codeHash}); err != nil {
+			return err
+		}
+		if err = kvTx.AddIndex(recoveryCodeSubjectIDIndex, subjectID, id.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *kvStore) TxUseRecoveryCode(tx store.SQLTransactional, subjectID, codeHash string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	ids, err := kvTx.Lookup(recoveryCodeSubjectIDIndex, subjectID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		code := RecoveryCode{}
+		if _, err = kvTx.Get(recoveryCodeTable, id, &code); err != nil {
+			return err
+		}
+		if code.CodeHash == codeHash && !code.Used {
+			code.Used = true
+			return kvTx.Put(recoveryCodeTable, id, code)
+		}
+	}
+	return errors.Error("invalid recovery code")
+}
+
+func (s *kvStore) TxAddSession(tx store.SQLTransactional, session *Session) (*Session, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err = requireSubject(kvTx, session.SubjectID, "mfa_sessions_subject_id_fkey", "mfa session for "+session.SubjectID); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id.String()
+	if err = kvTx.Put(sessionTable, session.ID, session); err != nil {
+		return nil, err
+	}
+	return session, kvTx.AddIndex(sessionSubjectIDIndex, session.SubjectID, session.ID)
+}
+
+func (s *kvStore) GetSession(sessionID string) (session *Session, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		found := &Session{}
+		ok, err := kvTx.Get(sessionTable, sessionID, found)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Error("no mfa session found for " + sessionID)
+		}
+		session = found
+		return nil
+	})
+	return
+}
+
+func (s *kvStore) WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *kvStore) deleteRecoveryCodes(tx *store.KVTx, subjectID string) error {
+	ids, err := tx.Lookup(recoveryCodeSubjectIDIndex, subjectID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = tx.RemoveIndex(recoveryCodeSubjectIDIndex, subjectID, id); err != nil {
+			return err
+		}
+		if _, err = tx.Delete(recoveryCodeTable, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func requireSubject(tx *store.KVTx, subjectID, constraint, msg string) error {
+	subject, err := tx.GetRaw("subjects", subjectID)
+	if err != nil {
+		return err
+	}
+	if subject == nil {
+		return &store.ReferenceError{Constraint: constraint, Msg: msg + " references a missing record"}
+	}
+	return nil
+}
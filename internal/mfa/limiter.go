@@ -0,0 +1,65 @@
+package mfa
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxFailedAttempts    = 5
+	failedAttemptsWindow = 15 * time.Minute
+)
+
+// attemptLimiter locks a subject out of code verification after too many failed attempts within a window.
+// Counts are kept per process, so each replica of the server enforces the limit on its own.
+type attemptLimiter struct {
+	mu       sync.Mutex
+	failures map[string]*failedAttempts
+}
+
+type failedAttempts struct {
+	count int
+	since time.Time
+}
+
+func newAttemptLimiter() *attemptLimiter {
+	return &attemptLimiter{failures: make(map[string]*failedAttempts)}
+}
+
+// allow returns an error while the subject is locked out
+func (l *attemptLimiter) allow(subjectID string, now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	attempts, ok := l.failures[subjectID]
+	if !ok {
+		return nil
+	}
+	if now.Sub(attempts.since) >= failedAttemptsWindow {
+		delete(l.failures, subjectID)
+		return nil
+	}
+	if attempts.count >= maxFailedAttempts {
+		return status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+	}
+	return nil
+}
+
+func (l *attemptLimiter) fail(subjectID string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	attempts, ok := l.failures[subjectID]
+	if !ok || now.Sub(attempts.since) >= failedAttemptsWindow {
+		attempts = &failedAttempts{since: now}
+		l.failures[subjectID] = attempts
+	}
+	attempts.count++
+}
+
+func (l *attemptLimiter) succeed(subjectID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, subjectID)
+}
@@ -0,0 +1,167 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	enrollmentTable   = "mfa_enrollments"
+	recoveryCodeTable = "mfa_recovery_codes"
+	sessionTable      = "mfa_sessions"
+)
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	db.RegisterCascade("subjects", enrollmentTable, func(row interface{}) string {
+		return row.(Enrollment).SubjectID
+	})
+	db.RegisterCascade("subjects", recoveryCodeTable, func(row interface{}) string {
+		return row.(RecoveryCode).SubjectID
+	})
+	db.RegisterCascade("subjects", sessionTable, func(row interface{}) string {
+		return row.(Session).SubjectID
+	})
+	return &memoryStore{db: db}
+}
+
+func (s *memoryStore) TxGetEnrollment(tx store.SQLTransactional, subjectID string) (*Enrollment, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	row, ok := memoryTx.Get(enrollmentTable, subjectID)
+	if !ok {
+		return nil, &store.NotFoundError{Msg: "no totp enrollment found for " + subjectID}
+	}
+	enrollment := row.(Enrollment)
+	return &enrollment, nil
+}
+
+func (s *memoryStore) TxPutEnrollment(tx store.SQLTransactional, enrollment *Enrollment) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := memoryTx.Get("subjects", enrollment.SubjectID); !ok {
+		return &store.ReferenceError{Constraint: "mfa_enrollments_subject_id_fkey", Msg: "totp enrollment for " + enrollment.SubjectID + " references a missing record"}
+	}
+	memoryTx.Put(enrollmentTable, enrollment.SubjectID, *enrollment)
+	return nil
+}
+
+func (s *memoryStore) TxDeleteEnrollment(tx store.SQLTransactional, subjectID string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	s.deleteRecoveryCodes(memoryTx, subjectID)
+	if !memoryTx.Delete(enrollmentTable, subjectID) {
+		return errors.Error("no totp enrollment found for " + subjectID)
+	}
+	return nil
+}
+
+func (s *memoryStore) TxAdvanceStep(tx store.SQLTransactional, subjectID string, step int64) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	row, ok := memoryTx.Get(enrollmentTable, subjectID)
+	if !ok || row.(Enrollment).LastUsedStep >= step {
+		return errors.Error("totp code has already been used")
+	}
+	enrollment := row.(Enrollment)
+	enrollment.LastUsedStep = step
+	enrollment.Confirmed = true
+	memoryTx.Put(enrollmentTable, subjectID, enrollment)
+	return nil
+}
+
+func (s *memoryStore) TxReplaceRecoveryCodes(tx store.SQLTransactional, subjectID string, codeHashes []string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	s.deleteRecoveryCodes(memoryTx, subjectID)
+	for _, codeHash := range codeHashes {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		memoryTx.Put(recoveryCodeTable, id.String(), RecoveryCode{ID: id.String(), SubjectID: subjectID, CodeHash: codeHash})
+	}
+	return nil
+}
+
+func (s *memoryStore) TxUseRecoveryCode(tx store.SQLTransactional, subjectID, codeHash string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	codes := memoryTx.Scan(recoveryCodeTable, func(row interface{}) bool {
+		code := row.(RecoveryCode)
+		return code.SubjectID == subjectID && code.CodeHash == codeHash && !code.Used
+	})
+	if len(codes) == 0 {
+		return errors.Error("invalid recovery code")
+	}
+	for _, row := range codes {
+		code := row.(RecoveryCode)
+		code.Used = true
+		memoryTx.Put(recoveryCodeTable, code.ID, code)
+	}
+	return nil
+}
+
+func (s *memoryStore) TxAddSession(tx store.SQLTransactional, session *Session) (*Session, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := memoryTx.Get("subjects", session.SubjectID); !ok {
+		return nil, &store.ReferenceError{Constraint: "mfa_sessions_subject_id_fkey", Msg: "mfa session for " + session.SubjectID + " references a missing record"}
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id.String()
+	memoryTx.Put(sessionTable, session.ID, *session)
+	return session, nil
+}
+
+func (s *memoryStore) GetSession(sessionID string) (session *Session, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		row, ok := memoryTx.Get(sessionTable, sessionID)
+		if !ok {
+			return errors.Error("no mfa session found for " + sessionID)
+		}
+		found := row.(Session)
+		session = &found
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *memoryStore) deleteRecoveryCodes(tx *store.MemoryTx, subjectID string) {
+	for _, row := range tx.Scan(recoveryCodeTable, func(row interface{}) bool {
+		return row.(RecoveryCode).SubjectID == subjectID
+	}) {
+		tx.Delete(recoveryCodeTable, row.(RecoveryCode).ID)
+	}
+}
@@ -0,0 +1,131 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	// TxGetEnrollment fails with a store.NotFoundError when the subject has not enrolled
+	TxGetEnrollment(tx store.SQLTransactional, subjectID string) (*Enrollment, error)
+	TxPutEnrollment(tx store.SQLTransactional, enrollment *Enrollment) error
+	TxDeleteEnrollment(tx store.SQLTransactional, subjectID string) error
+	// moves last_used_step forward only if the step is newer than the stored one, and confirms the enrollment
+	TxAdvanceStep(tx store.SQLTransactional, subjectID string, step int64) error
+	TxReplaceRecoveryCodes(tx store.SQLTransactional, subjectID string, codeHashes []string) error
+	TxUseRecoveryCode(tx store.SQLTransactional, subjectID, codeHash string) error
+	TxAddSession(tx store.SQLTransactional, session *Session) (*Session, error)
+	GetSession(sessionID string) (*Session, error)
+	WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error
+}
+
+type sqlStore struct {
+	db *sqlx.DB
+}
+
+func NewSQLStore(db *sqlx.DB) Store {
+	return &sqlStore{db: db}
+}
+
+func (s *sqlStore) TxGetEnrollment(tx store.SQLTransactional, subjectID string) (*Enrollment, error) {
+	enrollments := []Enrollment{}
+	err := tx.Select(&enrollments, "SELECT * FROM mfa_enrollments WHERE subject_id = $1", subjectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(enrollments) == 0 {
+		return nil, &store.NotFoundError{Msg: "no totp enrollment found for " + subjectID}
+	}
+	return &enrollments[0], nil
+}
+
+func (s *sqlStore) TxPutEnrollment(tx store.SQLTransactional, enrollment *Enrollment) error {
+	res, err := tx.Exec("INSERT INTO mfa_enrollments (subject_id, secret, last_used_step, confirmed) VALUES ($1, $2, $3, $4) ON CONFLICT (subject_id) DO UPDATE SET secret = $2, last_used_step = $3, confirmed = $4",
+		enrollment.SubjectID, enrollment.Secret, enrollment.LastUsedStep, enrollment.Confirmed)
+	if err != nil {
+		return store.TranslateError(err, "totp enrollment for "+enrollment.SubjectID)
+	}
+	return store.CheckErrorForRowsAffected(res, "totp enrollment for "+enrollment.SubjectID+" is not saved")
+}
+
+func (s *sqlStore) TxDeleteEnrollment(tx store.SQLTransactional, subjectID string) error {
+	if _, err := tx.Exec("DELETE FROM mfa_recovery_codes WHERE subject_id = $1", subjectID); err != nil {
+		return err
+	}
+	res, err := tx.Exec("DELETE FROM mfa_enrollments WHERE subject_id = $1", subjectID)
+	if err != nil {
+		return err
+	}
+	return store.CheckErrorForRowsAffected(res, "no totp enrollment found for "+subjectID)
+}
+
+func (s *sqlStore) TxAdvanceStep(tx store.SQLTransactional, subjectID string, step int64) error {
+	res, err := tx.Exec("UPDATE mfa_enrollments SET last_used_step = $2, confirmed = TRUE WHERE subject_id = $1 AND last_used_step < $2", subjectID, step)
+	if err != nil {
+		return err
+	}
+	// a concurrent verification already consumed this step
+	return store.CheckErrorForRowsAffected(res, "totp code has already been used")
+}
+
+func (s *sqlStore) TxReplaceRecoveryCodes(tx store.SQLTransactional, subjectID string, codeHashes []string) error {
+	if _, err := tx.Exec("DELETE FROM mfa_recovery_codes WHERE subject_id = $1", subjectID); err != nil {
+		return err
+	}
+	for _, codeHash := range codeHashes {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec("INSERT INTO mfa_recovery_codes (id, subject_id, code_hash, used) VALUES ($1, $2, $3, FALSE)", id.String(), subjectID, codeHash)
+		if err != nil {
+			return err
+		}
+		if err = store.CheckErrorForRowsAffected(res, "recovery code is not inserted for "+subjectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlStore) TxUseRecoveryCode(tx store.SQLTransactional, subjectID, codeHash string) error {
+	res, err := tx.Exec("UPDATE mfa_recovery_codes SET used = TRUE WHERE subject_id = $1 AND code_hash = $2 AND used = FALSE", subjectID, codeHash)
+	if err != nil {
+		return err
+	}
+	return store.CheckErrorForRowsAffected(res, "invalid recovery code")
+}
+
+func (s *sqlStore) TxAddSession(tx store.SQLTransactional, session *Session) (*Session, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id.String()
+	res, err := tx.Exec("INSERT INTO mfa_sessions (id, subject_id, authenticated_at, expires_at) VALUES ($1, $2, $3, $4)",
+		session.ID, session.SubjectID, session.AuthenticatedAt, session.ExpiresAt)
+	if err != nil {
+		return nil, store.TranslateError(err, "mfa session for "+session.SubjectID)
+	}
+	return session, store.CheckErrorForRowsAffected(res, "mfa session is not inserted for "+session.SubjectID)
+}
+
+func (s *sqlStore) GetSession(sessionID string) (*Session, error) {
+	sessions := []Session{}
+	err := s.db.Select(&sessions, "SELECT * FROM mfa_sessions WHERE id = $1", sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, errors.Error("no mfa session found for " + sessionID)
+	}
+	return &sessions[0], nil
+}
+
+func (s *sqlStore) WithTx(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return store.WithSQLXTxContext(ctx, s.db, cb)
+}
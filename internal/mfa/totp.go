@@ -0,0 +1,86 @@
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 defaults, which is what every common authenticator app expects
+const (
+	totpDigits     = 6
+	totpPeriod     = 30
+	totpSecretSize = 20
+	// number of steps accepted before and after the current one to tolerate clock skew
+	totpSkewSteps = 1
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+func OTPAuthURI(issuer, accountName, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func TimeStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+func GenerateCode(secret string, step int64) (string, error) {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	// dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	binCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, binCode%1000000), nil
+}
+
+// MatchCode returns the step the code was generated for, or -1 if the code does not match any step
+// within the skew window that is after lastUsedStep
+func MatchCode(secret, code string, now time.Time, lastUsedStep int64) (int64, error) {
+	if len(code) != totpDigits {
+		return -1, nil
+	}
+	current := TimeStep(now)
+	for step := current - totpSkewSteps; step <= current+totpSkewSteps; step++ {
+		if step <= lastUsedStep {
+			// replayed or older than an already accepted code
+			continue
+		}
+		expected, err := GenerateCode(secret, step)
+		if err != nil {
+			return -1, err
+		}
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, nil
+		}
+	}
+	return -1, nil
+}
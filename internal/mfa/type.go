@@ -0,0 +1,29 @@
+package mfa
+
+import "time"
+
+/*
+ * A TOTP enrollment holds the shared secret of a subject's authenticator.
+ * LastUsedStep is the last accepted time step, codes at or before it are rejected.
+ */
+
+type Enrollment struct {
+	SubjectID    string `db:"subject_id"`
+	Secret       string `db:"secret"`
+	LastUsedStep int64  `db:"last_used_step"`
+	Confirmed    bool   `db:"confirmed"`
+}
+
+type RecoveryCode struct {
+	ID        string `db:"id"`
+	SubjectID string `db:"subject_id"`
+	CodeHash  string `db:"code_hash"`
+	Used      bool   `db:"used"`
+}
+
+type Session struct {
+	ID              string    `db:"id"`
+	SubjectID       string    `db:"subject_id"`
+	AuthenticatedAt time.Time `db:"authenticated_at"`
+	ExpiresAt       time.Time `db:"expires_at"`
+}
@@ -0,0 +1,115 @@
+package migration
+
+import (
+	"context"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// hooks run inside the migration transaction, after the up script and before the down script
+type hooks struct {
+	afterUp    func(ctx context.Context, tx *sqlx.Tx) error
+	beforeDown func(ctx context.Context, tx *sqlx.Tx) error
+}
+
+var postgresHooks = map[int64]hooks{
+	6:  {afterUp: payloadsToDocuments(documentTables), beforeDown: documentsToPayloads(documentTables)},
+	14: {afterUp: payloadsToDocuments(entityTables)},
+	15: {afterUp: indexPolicyTargets},
+	16: {afterUp: indexAccessRequests},
+}
+
+var sqliteHooks = map[int64]hooks{
+	10: {afterUp: indexPolicyTargets},
+	11: {afterUp: indexAccessRequests},
+}
+
+// tables holding protobuf entities at migration 6 and the message stored in them
+var documentTables = map[string]func() proto.Message{
+	"groups":   func() proto.Message { return &pb.Group{} },
+	"policies": func() proto.Message { return &pb.Policy{} },
+}
+
+// tables holding protobuf entities at migration 14, rows written in the protobuf format before it have no document
+var entityTables = map[string]func() proto.Message{
+	"groups":          func() proto.Message { return &pb.Group{} },
+	"policies":        func() proto.Message { return &pb.Policy{} },
+	"roles":           func() proto.Message { return &pb.Role{} },
+	"access_requests": func() proto.Message { return &pb.AccessRequest{} },
+	"sod_rules":       func() proto.Message { return &pb.SoDRule{} },
+}
+
+type documentRow struct {
+	ID       string `db:"id"`
+	Payload  []byte `db:"payload"`
+	Document []byte `db:"document"`
+}
+
+// decodeRow reads a row the way the entity stores wrote it when the hooks were added, the payload is preferred
+func decodeRow(row documentRow, message proto.Message) error {
+	if len(row.Payload) > 0 {
+		return proto.Unmarshal(row.Payload, message)
+	}
+	return protojson.Unmarshal(row.Document, message)
+}
+
+func payloadsToDocuments(tables map[string]func() proto.Message) func(ctx context.Context, tx *sqlx.Tx) error {
+	return func(ctx context.Context, tx *sqlx.Tx) error {
+		return convertPayloads(ctx, tx, tables)
+	}
+}
+
+func documentsToPayloads(tables map[string]func() proto.Message) func(ctx context.Context, tx *sqlx.Tx) error {
+	return func(ctx context.Context, tx *sqlx.Tx) error {
+		return convertDocuments(ctx, tx, tables)
+	}
+}
+
+func convertPayloads(ctx context.Context, tx *sqlx.Tx, tables map[string]func() proto.Message) error {
+	for table, newMessage := range tables {
+		rows := []documentRow{}
+		if err := tx.SelectContext(ctx, &rows, "SELECT id, payload, document FROM "+table+" WHERE document IS NULL AND payload IS NOT NULL"); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			message := newMessage()
+			if err := proto.Unmarshal(row.Payload, message); err != nil {
+				return err
+			}
+			document, err := protojson.Marshal(message)
+			if err != nil {
+				return err
+			}
+			if _, err = tx.ExecContext(ctx, "UPDATE "+table+" SET document = $2 WHERE id = $1", row.ID, string(document)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func convertDocuments(ctx context.Context, tx *sqlx.Tx, tables map[string]func() proto.Message) error {
+	for table, newMessage := range tables {
+		rows := []documentRow{}
+		if err := tx.SelectContext(ctx, &rows, "SELECT id, payload, document FROM "+table+" WHERE payload IS NULL AND document IS NOT NULL"); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			message := newMessage()
+			if err := protojson.Unmarshal(row.Document, message); err != nil {
+				return err
+			}
+			payload, err := proto.Marshal(message)
+			if err != nil {
+				return err
+			}
+			if _, err = tx.ExecContext(ctx, "UPDATE "+table+" SET payload = $2 WHERE id = $1", row.ID, payload); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"context"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/jmoiron/sqlx"
+)
+
+// indexPolicyTargets fills policy_targets with the targets of the existing policies
+func indexPolicyTargets(ctx context.Context, tx *sqlx.Tx) error {
+	rows := []documentRow{}
+	if err := tx.SelectContext(ctx, &rows, "SELECT id, payload, document FROM policies"); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		p := &pb.Policy{}
+		if err := decodeRow(row, p); err != nil {
+			return err
+		}
+		if err := insertPolicyTargets(ctx, tx, row.ID, p.GetTarget()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertPolicyTargets writes the rows of a policy as policy_targets was created, an empty list matches every value
+func insertPolicyTargets(ctx context.Context, tx *sqlx.Tx, policyID string, target *pb.PolicyTarget) error {
+	if target == nil {
+		return nil
+	}
+	actions, resourceTypes := target.GetActions(), target.GetResourceTypes()
+	if len(actions) == 0 {
+		actions = []string{""}
+	}
+	if len(resourceTypes) == 0 {
+		resourceTypes = []string{""}
+	}
+	for _, action := range actions {
+		for _, resourceType := range resourceTypes {
+			_, err := tx.ExecContext(ctx, "INSERT INTO policy_targets (policy_id, action, resource_type) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+				policyID, action, resourceType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexAccessRequests fills the indexed columns of the existing access requests
+func indexAccessRequests(ctx context.Context, tx *sqlx.Tx) error {
+	rows := []documentRow{}
+	if err := tx.SelectContext(ctx, &rows, "SELECT id, payload, document FROM access_requests"); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		request := &pb.AccessRequest{}
+		if err := decodeRow(row, request); err != nil {
+			return err
+		}
+		// the columns as they were added, pending requests of one subject for one group are unique
+		_, err := tx.ExecContext(ctx, "UPDATE access_requests SET subject_id = $2, group_id = $3, state = $4 WHERE id = $1",
+			row.ID, request.SubjectId, request.GroupId, request.State.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package migration
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"github.com/jmoiron/sqlx"
+)
+
+// arbitrary key shared by all replicas so only one of them migrates at a time
+const advisoryLockKey int64 = 7341290412
+
+const createSchemaMigrations = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version bigint,
+	name varchar(255),
+	applied_at timestamptz,
+	PRIMARY KEY ( version )
+);
+`
+
+const createSQLiteSchemaMigrations = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version bigint,
+	name text,
+	applied_at timestamp,
+	PRIMARY KEY ( version )
+);
+`
+
+type AppliedMigration struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+	hooks      map[int64]hooks
+	sqlite     bool
+	logger     logging.Logger
+}
+
+// NewMigrator picks the migration history matching the driver db was opened with
+func NewMigrator(db *sqlx.DB) (*Migrator, error) {
+	sqlite := db.DriverName() == store.SQLiteDriverName
+	dir, migrationHooks := postgresScriptDir, postgresHooks
+	if sqlite {
+		dir, migrationHooks = sqliteScriptDir, sqliteHooks
+	}
+	migrations, err := loadMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, hooks: migrationHooks, sqlite: sqlite, logger: logging.GlobalLogger.WithPrefix("[Migrator]")}, nil
+}
+
+// ExecMigration applies all pending migrations
+func ExecMigration(db *sqlx.DB) error {
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	return migrator.Up(context.Background())
+}
+
+func (m *Migrator) LatestVersion() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		statuses = make([]Status, len(m.migrations), len(m.migrations))
+		for i, migration := range m.migrations {
+			appliedMigration, ok := applied[migration.Version]
+			statuses[i] = Status{Migration: migration, Applied: ok, AppliedAt: appliedMigration.AppliedAt}
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.LatestVersion())
+}
+
+// Down reverts the latest steps applied migrations
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			if _, ok := applied[m.migrations[i].Version]; !ok {
+				continue
+			}
+			if err = m.revert(ctx, conn, m.migrations[i]); err != nil {
+				return err
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// To applies or reverts migrations until the schema is at the target version, 0 reverts everything
+func (m *Migrator) To(ctx context.Context, version int64) error {
+	if version != 0 && !m.hasVersion(version) {
+		return errors.Error("unknown migration version " + strconv.FormatInt(version, 10))
+	}
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; ok && migration.Version > version {
+				if err = m.revert(ctx, conn, migration); err != nil {
+					return err
+				}
+			}
+		}
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+				if err = m.apply(ctx, conn, migration); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Migrator) hasVersion(version int64) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// withLock runs cb on a single connection holding the migration advisory lock
+func (m *Migrator) withLock(ctx context.Context, cb func(conn *sqlx.Conn) error) error {
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if m.sqlite {
+		// a sqlite file has a single writer, each migration transaction takes the write lock
+		if _, err = conn.ExecContext(ctx, createSQLiteSchemaMigrations); err != nil {
+			return err
+		}
+		return cb(conn)
+	}
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey); err != nil {
+			m.logger.Errorf(ctx, "failed to release migration lock due to %s", err.Error())
+		}
+	}()
+	if _, err = conn.ExecContext(ctx, createSchemaMigrations); err != nil {
+		return err
+	}
+	return cb(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sqlx.Conn) (map[int64]AppliedMigration, error) {
+	rows := []AppliedMigration{}
+	if err := conn.SelectContext(ctx, &rows, "SELECT * FROM schema_migrations"); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]AppliedMigration)
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sqlx.Conn, migration Migration) error {
+	m.logger.Infof(ctx, "applying migration %d_%s", migration.Version, migration.Name)
+	return m.inTx(ctx, conn, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+			return errors.Error("failed to apply migration " + migration.Name + " due to " + err.Error())
+		}
+		if hook := m.hooks[migration.Version].afterUp; hook != nil {
+			if err := hook(ctx, tx); err != nil {
+				return errors.Error("failed to apply migration " + migration.Name + " due to " + err.Error())
+			}
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)", migration.Version, migration.Name, time.Now())
+		return err
+	})
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sqlx.Conn, migration Migration) error {
+	m.logger.Infof(ctx, "reverting migration %d_%s", migration.Version, migration.Name)
+	return m.inTx(ctx, conn, func(tx *sqlx.Tx) error {
+		if hook := m.hooks[migration.Version].beforeDown; hook != nil {
+			if err := hook(ctx, tx); err != nil {
+				return errors.Error("failed to revert migration " + migration.Name + " due to " + err.Error())
+			}
+		}
+		if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+			return errors.Error("failed to revert migration " + migration.Name + " due to " + err.Error())
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+		return err
+	})
+}
+
+// each migration and its bookkeeping row commit together, ddl is transactional in postgres and sqlite
+func (m *Migrator) inTx(ctx context.Context, conn *sqlx.Conn, cb func(tx *sqlx.Tx) error) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = cb(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -1,42 +1,85 @@
 package migration
 
-import "github.com/jmoiron/sqlx"
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 
-var v1 = `
-CREATE TABLE IF NOT EXISTS subjects (
-    id uuid,
-	user_id varchar(255),
-	PRIMARY KEY ( id )
-);
+	"github.com/dlshle/gommon/errors"
+)
 
-CREATE TABLE IF NOT EXISTS groups (
-	id uuid,
-	payload bytea,
-	PRIMARY KEY ( id )
-);
+// scripts are named <version>_<name>.up.sql and <version>_<name>.down.sql, postgres scripts live in scripts/
+// and sqlite has its own history in scripts/sqlite/
+//
+//go:embed scripts/*.sql scripts/sqlite/*.sql
+var scriptFS embed.FS
 
-CREATE TABLE IF NOT EXISTS policies (
-	id uuid,
-	payload bytea,
-	PRIMARY KEY ( id )
-);
+const (
+	postgresScriptDir = "scripts"
+	sqliteScriptDir   = "scripts/sqlite"
+)
 
-CREATE TABLE IF NOT EXISTS contracts (
-	id uuid,
-	subject_id uuid,
-	group_id uuid,
-	PRIMARY KEY ( id )
-);
-`
-
-var migration_scripts = []string{v1}
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
 
-func ExecMigration(db *sqlx.DB) error {
-	for _, migration := range migration_scripts {
-		_, err := db.Exec(migration)
+func loadMigrations(dir string) ([]Migration, error) {
+	files, err := fs.Glob(scriptFS, dir+"/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		var direction string
+		switch {
+		case strings.HasSuffix(base, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, errors.Error("migration script " + base + " is neither up nor down")
+		}
+		versionAndName := strings.SplitN(strings.TrimSuffix(base, "."+direction+".sql"), "_", 2)
+		if len(versionAndName) != 2 {
+			return nil, errors.Error("migration script " + base + " is not named <version>_<name>")
+		}
+		version, err := strconv.ParseInt(versionAndName[0], 10, 64)
 		if err != nil {
-			return err
+			return nil, errors.Error("invalid version in migration script " + base)
+		}
+		content, err := scriptFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: versionAndName[1]}
+			byVersion[version] = migration
+		} else if migration.Name != versionAndName[1] {
+			return nil, errors.Error("conflicting names for migration version " + versionAndName[0])
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, errors.Error("migration " + strconv.FormatInt(migration.Version, 10) + " must have both up and down scripts")
 		}
+		migrations = append(migrations, *migration)
 	}
-	return nil
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
 }
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS contracts;
+DROP TABLE IF EXISTS policies;
+DROP TABLE IF EXISTS groups;
+DROP TABLE IF EXISTS subjects;
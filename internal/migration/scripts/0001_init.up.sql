@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS subjects (
+	id uuid,
+	user_id varchar(255),
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS groups (
+	id uuid,
+	payload bytea,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS policies (
+	id uuid,
+	payload bytea,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS contracts (
+	id uuid,
+	subject_id uuid,
+	group_id uuid,
+	PRIMARY KEY ( id )
+);
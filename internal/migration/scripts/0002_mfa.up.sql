@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS mfa_enrollments (
+	subject_id uuid,
+	secret varchar(255),
+	last_used_step bigint,
+	confirmed boolean,
+	PRIMARY KEY ( subject_id )
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+	id uuid,
+	subject_id uuid,
+	code_hash varchar(64),
+	used boolean,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS mfa_sessions (
+	id uuid,
+	subject_id uuid,
+	authenticated_at timestamptz,
+	expires_at timestamptz,
+	PRIMARY KEY ( id )
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS oidc_authorization_codes;
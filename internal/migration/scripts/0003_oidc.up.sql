@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS oidc_authorization_codes (
+	code_hash varchar(64),
+	client_id varchar(255),
+	subject_id uuid,
+	redirect_uri text,
+	scope text,
+	nonce text,
+	code_challenge varchar(255),
+	code_challenge_method varchar(16),
+	auth_time timestamptz,
+	expires_at timestamptz,
+	PRIMARY KEY ( code_hash )
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS federated_identities (
+	issuer varchar(255),
+	external_subject varchar(255),
+	subject_id uuid,
+	created_at timestamptz,
+	PRIMARY KEY ( issuer, external_subject )
+);
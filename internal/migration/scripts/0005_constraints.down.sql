@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS federated_identities_subject_id_idx;
+ALTER TABLE federated_identities DROP CONSTRAINT IF EXISTS federated_identities_subject_id_fkey;
+ALTER TABLE oidc_authorization_codes DROP CONSTRAINT IF EXISTS oidc_authorization_codes_subject_id_fkey;
+DROP INDEX IF EXISTS mfa_sessions_subject_id_idx;
+ALTER TABLE mfa_sessions DROP CONSTRAINT IF EXISTS mfa_sessions_subject_id_fkey;
+DROP INDEX IF EXISTS mfa_recovery_codes_subject_id_idx;
+ALTER TABLE mfa_recovery_codes DROP CONSTRAINT IF EXISTS mfa_recovery_codes_subject_id_fkey;
+ALTER TABLE mfa_enrollments DROP CONSTRAINT IF EXISTS mfa_enrollments_subject_id_fkey;
+
+DROP INDEX IF EXISTS subjects_user_id_idx;
+
+DROP INDEX IF EXISTS contracts_group_id_idx;
+DROP INDEX IF EXISTS contracts_subject_id_group_id_key;
+ALTER TABLE contracts
+	DROP CONSTRAINT IF EXISTS contracts_group_id_fkey,
+	DROP CONSTRAINT IF EXISTS contracts_subject_id_fkey,
+	ALTER COLUMN group_id DROP NOT NULL,
+	ALTER COLUMN subject_id DROP NOT NULL;
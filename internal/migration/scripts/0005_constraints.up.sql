@@ -0,0 +1,36 @@
+-- drop rows that would violate the new constraints
+DELETE FROM contracts c
+	WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = c.subject_id)
+	OR NOT EXISTS (SELECT 1 FROM groups g WHERE g.id = c.group_id);
+DELETE FROM contracts a USING contracts b
+	WHERE a.subject_id = b.subject_id AND a.group_id = b.group_id AND a.id > b.id;
+DELETE FROM mfa_enrollments e WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = e.subject_id);
+DELETE FROM mfa_recovery_codes r WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = r.subject_id);
+DELETE FROM mfa_sessions m WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = m.subject_id);
+DELETE FROM oidc_authorization_codes o WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = o.subject_id);
+DELETE FROM federated_identities f WHERE NOT EXISTS (SELECT 1 FROM subjects s WHERE s.id = f.subject_id);
+
+ALTER TABLE contracts
+	ALTER COLUMN subject_id SET NOT NULL,
+	ALTER COLUMN group_id SET NOT NULL,
+	ADD CONSTRAINT contracts_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE,
+	ADD CONSTRAINT contracts_group_id_fkey FOREIGN KEY ( group_id ) REFERENCES groups ( id ) ON DELETE CASCADE;
+-- also serves lookups by subject_id
+CREATE UNIQUE INDEX contracts_subject_id_group_id_key ON contracts ( subject_id, group_id );
+CREATE INDEX contracts_group_id_idx ON contracts ( group_id );
+
+CREATE INDEX subjects_user_id_idx ON subjects ( user_id );
+
+ALTER TABLE mfa_enrollments
+	ADD CONSTRAINT mfa_enrollments_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE;
+ALTER TABLE mfa_recovery_codes
+	ADD CONSTRAINT mfa_recovery_codes_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE;
+CREATE INDEX mfa_recovery_codes_subject_id_idx ON mfa_recovery_codes ( subject_id );
+ALTER TABLE mfa_sessions
+	ADD CONSTRAINT mfa_sessions_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE;
+CREATE INDEX mfa_sessions_subject_id_idx ON mfa_sessions ( subject_id );
+ALTER TABLE oidc_authorization_codes
+	ADD CONSTRAINT oidc_authorization_codes_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE;
+ALTER TABLE federated_identities
+	ADD CONSTRAINT federated_identities_subject_id_fkey FOREIGN KEY ( subject_id ) REFERENCES subjects ( id ) ON DELETE CASCADE;
+CREATE INDEX federated_identities_subject_id_idx ON federated_identities ( subject_id );
@@ -0,0 +1,5 @@
+-- rows written as documents are converted back to payloads by the migration's go step first
+DROP INDEX IF EXISTS policies_document_idx;
+DROP INDEX IF EXISTS groups_document_idx;
+ALTER TABLE policies DROP COLUMN IF EXISTS document;
+ALTER TABLE groups DROP COLUMN IF EXISTS document;
@@ -0,0 +1,6 @@
+-- existing payloads are converted to documents by the migration's go step
+ALTER TABLE groups ADD COLUMN document jsonb;
+ALTER TABLE policies ADD COLUMN document jsonb;
+-- jsonb_path_ops serves the @> containment queries
+CREATE INDEX groups_document_idx ON groups USING GIN ( document jsonb_path_ops );
+CREATE INDEX policies_document_idx ON policies USING GIN ( document jsonb_path_ops );
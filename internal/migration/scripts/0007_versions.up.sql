@@ -0,0 +1,3 @@
+-- bumped by every write, updates carrying a stale version are rejected
+ALTER TABLE groups ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE policies ADD COLUMN version bigint NOT NULL DEFAULT 1;
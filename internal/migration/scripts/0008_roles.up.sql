@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS roles (
+	id uuid,
+	payload bytea,
+	document jsonb,
+	version bigint NOT NULL DEFAULT 1,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX roles_document_idx ON roles USING GIN ( document jsonb_path_ops );
@@ -0,0 +1,11 @@
+-- subject sets leave subject_id empty and subject ids leave the subject_* columns empty
+CREATE TABLE IF NOT EXISTS relation_tuples (
+	namespace varchar(255),
+	object_id varchar(255),
+	relation varchar(255),
+	subject_id varchar(255) NOT NULL DEFAULT '',
+	subject_namespace varchar(255) NOT NULL DEFAULT '',
+	subject_object_id varchar(255) NOT NULL DEFAULT '',
+	subject_relation varchar(255) NOT NULL DEFAULT '',
+	PRIMARY KEY ( namespace, object_id, relation, subject_id, subject_namespace, subject_object_id, subject_relation )
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS contracts_expires_at_idx;
+ALTER TABLE contracts DROP COLUMN expires_at;
+ALTER TABLE contracts DROP COLUMN not_before;
@@ -0,0 +1,4 @@
+-- null bounds are open, contracts outside their window are ignored and expired ones are reaped
+ALTER TABLE contracts ADD COLUMN not_before timestamptz;
+ALTER TABLE contracts ADD COLUMN expires_at timestamptz;
+CREATE INDEX contracts_expires_at_idx ON contracts ( expires_at ) WHERE expires_at IS NOT NULL;
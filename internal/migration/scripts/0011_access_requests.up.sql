@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS access_requests (
+	id uuid,
+	payload bytea,
+	document jsonb,
+	version bigint NOT NULL DEFAULT 1,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX access_requests_document_idx ON access_requests USING GIN ( document jsonb_path_ops );
@@ -0,0 +1,2 @@
+ALTER TABLE contracts DROP COLUMN break_glass_granted_by;
+ALTER TABLE contracts DROP COLUMN break_glass_justification;
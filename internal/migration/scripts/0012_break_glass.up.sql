@@ -0,0 +1,3 @@
+-- set on emergency contracts only, regular contracts have no justification
+ALTER TABLE contracts ADD COLUMN break_glass_justification text;
+ALTER TABLE contracts ADD COLUMN break_glass_granted_by text;
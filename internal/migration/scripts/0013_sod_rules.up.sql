@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS sod_rules (
+	id uuid,
+	payload bytea,
+	document jsonb,
+	version bigint NOT NULL DEFAULT 1,
+	PRIMARY KEY ( id )
+);
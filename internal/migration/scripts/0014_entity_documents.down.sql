@@ -0,0 +1 @@
+DROP INDEX IF EXISTS sod_rules_document_idx;
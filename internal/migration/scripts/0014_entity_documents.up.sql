@@ -0,0 +1,3 @@
+-- documents of rows written in the protobuf format are filled in by the migration's go step, afterwards every
+-- row has one and containment queries are answered by the GIN indexes alone
+CREATE INDEX sod_rules_document_idx ON sod_rules USING GIN ( document jsonb_path_ops );
@@ -0,0 +1,7 @@
+-- copies of fields of the request for indexed lookups, filled in by the migration's go step
+ALTER TABLE access_requests ADD COLUMN subject_id text;
+ALTER TABLE access_requests ADD COLUMN group_id text;
+ALTER TABLE access_requests ADD COLUMN state text;
+CREATE INDEX access_requests_state_subject_id_idx ON access_requests ( state, subject_id );
+-- a subject has at most one pending request per group
+CREATE UNIQUE INDEX access_requests_pending_key ON access_requests ( subject_id, group_id ) WHERE state = 'PENDING';
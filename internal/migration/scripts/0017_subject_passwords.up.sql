@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS subject_passwords (
+	subject_id uuid REFERENCES subjects ( id ) ON DELETE CASCADE,
+	password_hash varchar(255),
+	PRIMARY KEY ( subject_id )
+);
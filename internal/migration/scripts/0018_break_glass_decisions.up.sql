@@ -0,0 +1,11 @@
+-- contracts are deleted when they expire, the decisions made with them are kept
+CREATE TABLE IF NOT EXISTS break_glass_decisions (
+	id uuid,
+	contract_id uuid NOT NULL,
+	subject_id uuid NOT NULL,
+	check_name text NOT NULL,
+	verdict varchar(32) NOT NULL,
+	decided_at timestamptz NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX IF NOT EXISTS break_glass_decisions_contract_id_idx ON break_glass_decisions ( contract_id );
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS federated_identities;
+DROP TABLE IF EXISTS oidc_authorization_codes;
+DROP TABLE IF EXISTS mfa_sessions;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS mfa_enrollments;
+DROP TABLE IF EXISTS contracts;
+DROP TABLE IF EXISTS policies;
+DROP TABLE IF EXISTS groups;
+DROP TABLE IF EXISTS subjects;
@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS subjects (
+	id text,
+	user_id text,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX subjects_user_id_idx ON subjects ( user_id );
+
+CREATE TABLE IF NOT EXISTS groups (
+	id text,
+	payload blob,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS policies (
+	id text,
+	payload blob,
+	PRIMARY KEY ( id )
+);
+
+CREATE TABLE IF NOT EXISTS contracts (
+	id text,
+	subject_id text NOT NULL REFERENCES subjects ( id ) ON DELETE CASCADE,
+	group_id text NOT NULL REFERENCES groups ( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( id )
+);
+CREATE UNIQUE INDEX contracts_subject_id_group_id_key ON contracts ( subject_id, group_id );
+CREATE INDEX contracts_group_id_idx ON contracts ( group_id );
+
+CREATE TABLE IF NOT EXISTS mfa_enrollments (
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	secret text,
+	last_used_step bigint,
+	confirmed boolean,
+	PRIMARY KEY ( subject_id )
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+	id text,
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	code_hash text,
+	used boolean,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX mfa_recovery_codes_subject_id_idx ON mfa_recovery_codes ( subject_id );
+
+-- timestamps are declared as timestamp so the driver scans them into time.Time
+CREATE TABLE IF NOT EXISTS mfa_sessions (
+	id text,
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	authenticated_at timestamp,
+	expires_at timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX mfa_sessions_subject_id_idx ON mfa_sessions ( subject_id );
+
+CREATE TABLE IF NOT EXISTS oidc_authorization_codes (
+	code_hash text,
+	client_id text,
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	redirect_uri text,
+	scope text,
+	nonce text,
+	code_challenge text,
+	code_challenge_method text,
+	auth_time timestamp,
+	expires_at timestamp,
+	PRIMARY KEY ( code_hash )
+);
+
+CREATE TABLE IF NOT EXISTS federated_identities (
+	issuer text,
+	external_subject text,
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	created_at timestamp,
+	PRIMARY KEY ( issuer, external_subject )
+);
+CREATE INDEX federated_identities_subject_id_idx ON federated_identities ( subject_id );
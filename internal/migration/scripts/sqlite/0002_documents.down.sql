@@ -0,0 +1,2 @@
+ALTER TABLE policies DROP COLUMN document;
+ALTER TABLE groups DROP COLUMN document;
@@ -0,0 +1,3 @@
+-- documents can be read but sqlite always writes protobuf payloads
+ALTER TABLE groups ADD COLUMN document text;
+ALTER TABLE policies ADD COLUMN document text;
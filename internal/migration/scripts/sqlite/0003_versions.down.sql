@@ -0,0 +1,2 @@
+ALTER TABLE policies DROP COLUMN version;
+ALTER TABLE groups DROP COLUMN version;
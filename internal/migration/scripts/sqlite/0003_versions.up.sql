@@ -0,0 +1,2 @@
+ALTER TABLE groups ADD COLUMN version integer NOT NULL DEFAULT 1;
+ALTER TABLE policies ADD COLUMN version integer NOT NULL DEFAULT 1;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS roles (
+	id text,
+	payload blob,
+	document text,
+	version integer NOT NULL DEFAULT 1,
+	PRIMARY KEY ( id )
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS relation_tuples (
+	namespace text,
+	object_id text,
+	relation text,
+	subject_id text NOT NULL DEFAULT '',
+	subject_namespace text NOT NULL DEFAULT '',
+	subject_object_id text NOT NULL DEFAULT '',
+	subject_relation text NOT NULL DEFAULT '',
+	PRIMARY KEY ( namespace, object_id, relation, subject_id, subject_namespace, subject_object_id, subject_relation )
+);
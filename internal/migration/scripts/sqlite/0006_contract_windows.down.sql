@@ -0,0 +1,2 @@
+ALTER TABLE contracts DROP COLUMN expires_at;
+ALTER TABLE contracts DROP COLUMN not_before;
@@ -0,0 +1,2 @@
+ALTER TABLE contracts ADD COLUMN not_before timestamp;
+ALTER TABLE contracts ADD COLUMN expires_at timestamp;
@@ -0,0 +1,2 @@
+ALTER TABLE contracts ADD COLUMN break_glass_justification text;
+ALTER TABLE contracts ADD COLUMN break_glass_granted_by text;
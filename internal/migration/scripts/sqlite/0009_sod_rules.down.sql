@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sod_rules;
@@ -0,0 +1,8 @@
+-- one row per action and resource type of a policy's target, '' matches any; filled in by the migration's go step
+CREATE TABLE IF NOT EXISTS policy_targets (
+	policy_id text NOT NULL REFERENCES policies ( id ) ON DELETE CASCADE,
+	action text NOT NULL,
+	resource_type text NOT NULL,
+	PRIMARY KEY ( action, resource_type, policy_id )
+);
+CREATE INDEX policy_targets_policy_id_idx ON policy_targets ( policy_id );
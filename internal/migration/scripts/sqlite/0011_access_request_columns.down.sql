@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS access_requests_pending_key;
+DROP INDEX IF EXISTS access_requests_state_subject_id_idx;
+ALTER TABLE access_requests DROP COLUMN state;
+ALTER TABLE access_requests DROP COLUMN group_id;
+ALTER TABLE access_requests DROP COLUMN subject_id;
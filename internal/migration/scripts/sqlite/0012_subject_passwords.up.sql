@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS subject_passwords (
+	subject_id text REFERENCES subjects ( id ) ON DELETE CASCADE,
+	password_hash text,
+	PRIMARY KEY ( subject_id )
+);
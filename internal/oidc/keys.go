@@ -0,0 +1,109 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+
+	"github.com/dlshle/gommon/errors"
+)
+
+const signingKeyBits = 2048
+
+type SigningKey struct {
+	ID         string
+	PrivateKey *rsa.PrivateKey
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadSigningKey reads a PEM encoded RSA private key, an ephemeral key is generated when path is empty
+func LoadSigningKey(path string) (*SigningKey, error) {
+	var (
+		privateKey *rsa.PrivateKey
+		err        error
+	)
+	if path == "" {
+		privateKey, err = rsa.GenerateKey(rand.Reader, signingKeyBits)
+	} else {
+		privateKey, err = parsePrivateKeyFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{ID: keyID(&privateKey.PublicKey), PrivateKey: privateKey}, nil
+}
+
+func parsePrivateKeyFile(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Error("no PEM block found in " + path)
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.Error("signing key in " + path + " is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func (k *SigningKey) JWKS() JWKS {
+	return JWKS{Keys: []JWK{PublicKeyToJWK(k.ID, &k.PrivateKey.PublicKey)}}
+}
+
+func PublicKeyToJWK(kid string, publicKey *rsa.PublicKey) JWK {
+	return JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: kid,
+		N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}
+}
+
+func (k JWK) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, errors.Error("unsupported key type " + k.Kty + " for key " + k.Kid)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+}
+
+func keyID(publicKey *rsa.PublicKey) string {
+	sum := sha256.Sum256(x509.MarshalPKCS1PublicKey(publicKey))
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
@@ -0,0 +1,118 @@
+package oidc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+)
+
+const codeSubjectIDIndex = "oidc_authorization_codes_subject_id_idx"
+
+type kvStore struct {
+	db *store.KVDB
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	s := &kvStore{db: db}
+	db.RegisterCascade("subjects", func(tx *store.KVTx, subjectID string) error {
+		codeHashes, err := tx.Lookup(codeSubjectIDIndex, subjectID)
+		if err != nil {
+			return err
+		}
+		for _, codeHash := range codeHashes {
+			if err = s.delete(tx, codeHash, subjectID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return s
+}
+
+func (s *kvStore) PutCode(code *AuthorizationCode) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		existing, err := kvTx.GetRaw(codeTable, code.CodeHash)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return &store.DuplicateError{Constraint: "oidc_authorization_codes_pkey", Msg: "authorization code for " + code.SubjectID + " already exists"}
+		}
+		subject, err := kvTx.GetRaw("subjects", code.SubjectID)
+		if err != nil {
+			return err
+		}
+		if subject == nil {
+			return &store.ReferenceError{Constraint: "oidc_authorization_codes_subject_id_fkey", Msg: "authorization code for " + code.SubjectID + " references a missing record"}
+		}
+		if err = kvTx.Put(codeTable, code.CodeHash, code); err != nil {
+			return err
+		}
+		return kvTx.AddIndex(codeSubjectIDIndex, code.SubjectID, code.CodeHash)
+	})
+}
+
+func (s *kvStore) ConsumeCode(codeHash string) (code *AuthorizationCode, err error) {
+	err = s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		found := &AuthorizationCode{}
+		ok, err := kvTx.Get(codeTable, codeHash, found)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Error("authorization code not found")
+		}
+		code = found
+		return s.delete(kvTx, codeHash, found.SubjectID)
+	})
+	return
+}
+
+func (s *kvStore) DeleteExpiredCodes() error {
+	now := time.Now()
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		var expired []AuthorizationCode
+		err = kvTx.Scan(codeTable, func(key string, value []byte) error {
+			code := AuthorizationCode{}
+			if err := json.Unmarshal(value, &code); err != nil {
+				return err
+			}
+			if code.ExpiresAt.Before(now) {
+				expired = append(expired, code)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// bbolt does not allow deleting while iterating
+		for _, code := range expired {
+			if err = s.delete(kvTx, code.CodeHash, code.SubjectID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvStore) delete(tx *store.KVTx, codeHash, subjectID string) error {
+	if err := tx.RemoveIndex(codeSubjectIDIndex, subjectID, codeHash); err != nil {
+		return err
+	}
+	_, err := tx.Delete(codeTable, codeHash)
+	return err
+}
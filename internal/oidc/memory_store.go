@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+)
+
+const codeTable = "oidc_authorization_codes"
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	db.RegisterCascade("subjects", codeTable, func(row interface{}) string {
+		return row.(AuthorizationCode).SubjectID
+	})
+	return &memoryStore{db: db}
+}
+
+func (s *memoryStore) PutCode(code *AuthorizationCode) error {
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		if _, ok := memoryTx.Get(codeTable, code.CodeHash); ok {
+			return &store.DuplicateError{Constraint: "oidc_authorization_codes_pkey", Msg: "authorization code for " + code.SubjectID + " already exists"}
+		}
+		if _, ok := memoryTx.Get("subjects", code.SubjectID); !ok {
+			return &store.ReferenceError{Constraint: "oidc_authorization_codes_subject_id_fkey", Msg: "authorization code for " + code.SubjectID + " references a missing record"}
+		}
+		memoryTx.Put(codeTable, code.CodeHash, *code)
+		return nil
+	})
+}
+
+func (s *memoryStore) ConsumeCode(codeHash string) (code *AuthorizationCode, err error) {
+	err = s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		row, ok := memoryTx.Get(codeTable, codeHash)
+		if !ok {
+			return errors.Error("authorization code not found")
+		}
+		memoryTx.Delete(codeTable, codeHash)
+		consumed := row.(AuthorizationCode)
+		code = &consumed
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) DeleteExpiredCodes() error {
+	now := time.Now()
+	return s.db.WithTx(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		for _, row := range memoryTx.Scan(codeTable, func(row interface{}) bool {
+			return row.(AuthorizationCode).ExpiresAt.Before(now)
+		}) {
+			memoryTx.Delete(codeTable, row.(AuthorizationCode).CodeHash)
+		}
+		return nil
+	})
+}
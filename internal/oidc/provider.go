@@ -0,0 +1,524 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/mfa"
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	JWKSPath      = "/jwks"
+	AuthorizePath = "/authorize"
+	TokenPath     = "/token"
+	UserInfoPath  = "/userinfo"
+
+	defaultCodeTTL  = time.Minute
+	defaultTokenTTL = time.Hour
+)
+
+// standard claims that group attributes are not allowed to override
+var reservedClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "exp": true, "iat": true, "nbf": true,
+	"jti": true, "auth_time": true, "nonce": true, "amr": true, "scope": true, "client_id": true,
+}
+
+type Options struct {
+	Issuer   string
+	Clients  []Client
+	CodeTTL  time.Duration
+	TokenTTL time.Duration
+}
+
+type Provider struct {
+	store           Store
+	key             *SigningKey
+	issuer          string
+	clients         map[string]Client
+	codeTTL         time.Duration
+	tokenTTL        time.Duration
+	subjectHandler  *subject.Handler
+	mfaHandler      *mfa.Handler
+	contractHandler *contract.Handler
+	groupHandler    *group.Handler
+	logger          logging.Logger
+}
+
+func NewProvider(store Store, key *SigningKey, opts Options, subjectHandler *subject.Handler, mfaHandler *mfa.Handler, contractHandler *contract.Handler, groupHandler *group.Handler) *Provider {
+	clients := make(map[string]Client)
+	for _, client := range opts.Clients {
+		clients[client.ID] = client
+	}
+	if opts.CodeTTL <= 0 {
+		opts.CodeTTL = defaultCodeTTL
+	}
+	if opts.TokenTTL <= 0 {
+		opts.TokenTTL = defaultTokenTTL
+	}
+	return &Provider{
+		store:           store,
+		key:             key,
+		issuer:          strings.TrimRight(opts.Issuer, "/"),
+		clients:         clients,
+		codeTTL:         opts.CodeTTL,
+		tokenTTL:        opts.TokenTTL,
+		subjectHandler:  subjectHandler,
+		mfaHandler:      mfaHandler,
+		contractHandler: contractHandler,
+		groupHandler:    groupHandler,
+		logger:          logging.GlobalLogger.WithPrefix("[OIDCProvider]"),
+	}
+}
+
+// PurgeExpiredCodes deletes expired authorization codes every code ttl until ctx is done, expired codes are
+// already refused by the token endpoint
+func (p *Provider) PurgeExpiredCodes(ctx context.Context) {
+	ticker := time.NewTicker(p.codeTTL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := p.store.DeleteExpiredCodes(); err != nil {
+			p.logger.Errorf(ctx, "failed to delete expired authorization codes due to %s", err.Error())
+		}
+	}
+}
+
+func (p *Provider) Routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(DiscoveryPath, p.handleDiscovery)
+	mux.HandleFunc(JWKSPath, p.handleJWKS)
+	mux.HandleFunc(AuthorizePath, p.handleAuthorize)
+	mux.HandleFunc(TokenPath, p.handleToken)
+	mux.HandleFunc(UserInfoPath, p.handleUserInfo)
+	return mux
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + AuthorizePath,
+		"token_endpoint":                        p.issuer + TokenPath,
+		"userinfo_endpoint":                     p.issuer + UserInfoPath,
+		"jwks_uri":                              p.issuer + JWKSPath,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid", "profile"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "amr", "groups"},
+	})
+}
+
+func (p *Provider) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, p.key.JWKS())
+}
+
+type authorizeRequest struct {
+	client              Client
+	redirectURI         string
+	scope               string
+	state               string
+	nonce               string
+	codeChallenge       string
+	codeChallengeMethod string
+}
+
+func (p *Provider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "malformed request", http.StatusBadRequest)
+		return
+	}
+	client, ok := p.clients[r.Form.Get("client_id")]
+	if !ok {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+	redirectURI := r.Form.Get("redirect_uri")
+	if !client.HasRedirectURI(redirectURI) {
+		// never redirect to an unregistered uri
+		http.Error(w, "redirect_uri is not registered for client", http.StatusBadRequest)
+		return
+	}
+	req := authorizeRequest{
+		client:              client,
+		redirectURI:         redirectURI,
+		scope:               r.Form.Get("scope"),
+		state:               r.Form.Get("state"),
+		nonce:               r.Form.Get("nonce"),
+		codeChallenge:       r.Form.Get("code_challenge"),
+		codeChallengeMethod: r.Form.Get("code_challenge_method"),
+	}
+	switch {
+	case r.Form.Get("response_type") != "code":
+		p.redirectError(w, r, req, "unsupported_response_type", "only response_type=code is supported")
+		return
+	case !hasScope(req.scope, "openid"):
+		p.redirectError(w, r, req, "invalid_scope", "openid scope is required")
+		return
+	case req.codeChallenge == "":
+		p.redirectError(w, r, req, "invalid_request", "code_challenge is required")
+		return
+	case req.codeChallengeMethod != "S256":
+		// plain would hand the verifier to anyone who sees the authorization request
+		p.redirectError(w, r, req, "invalid_request", "code_challenge_method must be S256")
+		return
+	}
+	if r.Method == http.MethodGet {
+		p.renderLogin(w, http.StatusOK, r.Form, "")
+		return
+	}
+	subjectID, err := p.authenticate(r.Context(), r.Form.Get("username"), r.Form.Get("password"), r.Form.Get("otp"))
+	if err != nil {
+		p.logger.Warnf(r.Context(), "login failed for user %s due to %s", r.Form.Get("username"), err.Error())
+		p.renderLogin(w, http.StatusUnauthorized, r.Form, "invalid username, password or one-time code")
+		return
+	}
+	code, err := randomToken()
+	if err != nil {
+		http.Error(w, "server error", http.StatusInternalServerError)
+		return
+	}
+	now := time.Now()
+	err = p.store.PutCode(&AuthorizationCode{
+		CodeHash:            hashToken(code),
+		ClientID:            client.ID,
+		SubjectID:           subjectID,
+		RedirectURI:         redirectURI,
+		Scope:               req.scope,
+		Nonce:               req.nonce,
+		CodeChallenge:       req.codeChallenge,
+		CodeChallengeMethod: req.codeChallengeMethod,
+		AuthTime:            now,
+		ExpiresAt:           now.Add(p.codeTTL),
+	})
+	if err != nil {
+		p.logger.Errorf(r.Context(), "failed to save authorization code due to %s", err.Error())
+		p.redirectError(w, r, req, "server_error", "")
+		return
+	}
+	params := url.Values{}
+	params.Set("code", code)
+	if req.state != "" {
+		params.Set("state", req.state)
+	}
+	http.Redirect(w, r, appendQuery(redirectURI, params), http.StatusFound)
+}
+
+// authenticate resolves the user to a single subject and checks its password, then its totp code as the second
+// factor. Invalid passwords count towards the same lockout as invalid codes.
+func (p *Provider) authenticate(ctx context.Context, userID, password, otp string) (string, error) {
+	if userID == "" || password == "" || otp == "" {
+		return "", errors.Error("missing credentials")
+	}
+	subjects, err := p.subjectHandler.FindSubjectsByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(subjects) != 1 {
+		return "", errors.Error("user does not resolve to exactly one subject")
+	}
+	subjectID := subjects[0].Id
+	if err = p.mfaHandler.AllowAttempt(subjectID); err != nil {
+		return "", err
+	}
+	if err = p.subjectHandler.VerifyPassword(subjectID, password); err != nil {
+		p.mfaHandler.FailAttempt(subjectID)
+		return "", err
+	}
+	if _, err = p.mfaHandler.VerifyTOTP(ctx, subjectID, otp); err != nil {
+		return "", err
+	}
+	return subjectID, nil
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeTokenError(w, http.StatusBadRequest, "invalid_request", "malformed request")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeTokenError(w, http.StatusBadRequest, "unsupported_grant_type", "only authorization_code is supported")
+		return
+	}
+	client, err := p.authenticateClient(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Basic")
+		writeTokenError(w, http.StatusUnauthorized, "invalid_client", err.Error())
+		return
+	}
+	code, err := p.store.ConsumeCode(hashToken(r.PostForm.Get("code")))
+	if err != nil {
+		writeTokenError(w, http.StatusBadRequest, "invalid_grant", "invalid authorization code")
+		return
+	}
+	switch {
+	case time.Now().After(code.ExpiresAt):
+		writeTokenError(w, http.StatusBadRequest, "invalid_grant", "authorization code has expired")
+		return
+	case code.ClientID != client.ID:
+		writeTokenError(w, http.StatusBadRequest, "invalid_grant", "authorization code was issued to another client")
+		return
+	case code.RedirectURI != r.PostForm.Get("redirect_uri"):
+		writeTokenError(w, http.StatusBadRequest, "invalid_grant", "redirect_uri mismatch")
+		return
+	case !verifyCodeChallenge(code.CodeChallenge, code.CodeChallengeMethod, r.PostForm.Get("code_verifier")):
+		writeTokenError(w, http.StatusBadRequest, "invalid_grant", "invalid code_verifier")
+		return
+	}
+	accessToken, idToken, err := p.issueTokens(code)
+	if err != nil {
+		p.logger.Errorf(r.Context(), "failed to issue tokens due to %s", err.Error())
+		writeTokenError(w, http.StatusInternalServerError, "server_error", "")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"id_token":     idToken,
+		"token_type":   "Bearer",
+		"expires_in":   int64(p.tokenTTL.Seconds()),
+		"scope":        code.Scope,
+	})
+}
+
+func (p *Provider) authenticateClient(r *http.Request) (Client, error) {
+	clientID, clientSecret, hasBasic := r.BasicAuth()
+	if !hasBasic {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	client, ok := p.clients[clientID]
+	if !ok {
+		return Client{}, errors.Error("unknown client")
+	}
+	if client.IsPublic() {
+		return client, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
+		return Client{}, errors.Error("invalid client credentials")
+	}
+	return client, nil
+}
+
+func (p *Provider) issueTokens(code *AuthorizationCode) (accessToken string, idToken string, err error) {
+	now := time.Now()
+	jti, err := randomToken()
+	if err != nil {
+		return
+	}
+	accessToken, err = p.sign(jwt.MapClaims{
+		"iss":       p.issuer,
+		"sub":       code.SubjectID,
+		"aud":       p.issuer,
+		"client_id": code.ClientID,
+		"scope":     code.Scope,
+		"jti":       jti,
+		"iat":       now.Unix(),
+		"exp":       now.Add(p.tokenTTL).Unix(),
+	})
+	if err != nil {
+		return
+	}
+	idClaims := jwt.MapClaims{
+		"iss":       p.issuer,
+		"sub":       code.SubjectID,
+		"aud":       code.ClientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(p.tokenTTL).Unix(),
+		"auth_time": code.AuthTime.Unix(),
+		"amr":       []string{"pwd", "otp", "mfa"},
+	}
+	if code.Nonce != "" {
+		idClaims["nonce"] = code.Nonce
+	}
+	idToken, err = p.sign(idClaims)
+	return
+}
+
+func (p *Provider) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.key.ID
+	return token.SignedString(p.key.PrivateKey)
+}
+
+func (p *Provider) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	claims, err := p.verifyAccessToken(r.Header.Get("Authorization"))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeTokenError(w, http.StatusUnauthorized, "invalid_token", err.Error())
+		return
+	}
+	subjectID, _ := claims["sub"].(string)
+	groups, err := p.contractHandler.GetGroupsBySubjectID(r.Context(), subjectID)
+	if err != nil {
+		p.logger.Errorf(r.Context(), "failed to get groups for %s due to %s", subjectID, err.Error())
+		writeTokenError(w, http.StatusInternalServerError, "server_error", "")
+		return
+	}
+	// claims carry the attributes the groups inherit, like authorization decisions do
+	resolved, err := p.groupHandler.ResolveInheritance(r.Context(), groups)
+	if err != nil {
+		p.logger.Errorf(r.Context(), "failed to resolve inherited attributes for %s due to %s", subjectID, err.Error())
+		writeTokenError(w, http.StatusInternalServerError, "server_error", "")
+		return
+	}
+	userInfo := map[string]interface{}{}
+	for key, value := range group.FromPB(group.MergeGroups(resolved)).Attributes {
+		if !reservedClaims[key] {
+			userInfo[key] = value
+		}
+	}
+	groupIDs := make([]string, len(groups), len(groups))
+	for i, g := range groups {
+		groupIDs[i] = g.Id
+	}
+	userInfo["groups"] = groupIDs
+	userInfo["sub"] = subjectID
+	writeJSON(w, http.StatusOK, userInfo)
+}
+
+// VerifyAccessToken returns the subject id of a valid access token issued by this provider
+func (p *Provider) VerifyAccessToken(token string) (string, error) {
+	claims, err := p.verifyAccessToken("Bearer " + token)
+	if err != nil {
+		return "", err
+	}
+	subjectID, _ := claims["sub"].(string)
+	return subjectID, nil
+}
+
+func (p *Provider) verifyAccessToken(authorization string) (jwt.MapClaims, error) {
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return nil, errors.Error("missing bearer token")
+	}
+	token, err := jwt.Parse(strings.TrimPrefix(authorization, "Bearer "), func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.Error("unexpected signing method")
+		}
+		return &p.key.PrivateKey.PublicKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !claims.VerifyIssuer(p.issuer, true) || !claims.VerifyAudience(p.issuer, true) {
+		return nil, errors.Error("token is not an access token of this issuer")
+	}
+	return claims, nil
+}
+
+func (p *Provider) redirectError(w http.ResponseWriter, r *http.Request, req authorizeRequest, errCode, description string) {
+	params := url.Values{}
+	params.Set("error", errCode)
+	if description != "" {
+		params.Set("error_description", description)
+	}
+	if req.state != "" {
+		params.Set("state", req.state)
+	}
+	http.Redirect(w, r, appendQuery(req.redirectURI, params), http.StatusFound)
+}
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html><head><title>Sign in</title></head><body>
+<form method="POST" action="">
+{{range $key, $values := .Params}}{{range $values}}<input type="hidden" name="{{$key}}" value="{{.}}">{{end}}{{end}}
+{{if .Error}}<p>{{.Error}}</p>{{end}}
+<label>Username <input name="username" autocomplete="username"></label>
+<label>Password <input name="password" type="password" autocomplete="current-password"></label>
+<label>One-time code <input name="otp" inputmode="numeric" autocomplete="one-time-code"></label>
+<button type="submit">Sign in</button>
+</form></body></html>`))
+
+func (p *Provider) renderLogin(w http.ResponseWriter, status int, form url.Values, errMsg string) {
+	params := url.Values{}
+	for key, values := range form {
+		if key != "username" && key != "password" && key != "otp" {
+			params[key] = values
+		}
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	loginTemplate.Execute(w, map[string]interface{}{"Params": params, "Error": errMsg})
+}
+
+func verifyCodeChallenge(challenge, method, verifier string) bool {
+	if verifier == "" || method != "S256" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(base64.RawURLEncoding.EncodeToString(sum[:]))) == 1
+}
+
+func hasScope(scope, target string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == target {
+			return true
+		}
+	}
+	return false
+}
+
+func appendQuery(uri string, params url.Values) string {
+	if strings.Contains(uri, "?") {
+		return uri + "&" + params.Encode()
+	}
+	return uri + "?" + params.Encode()
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeTokenError(w http.ResponseWriter, status int, errCode, description string) {
+	body := map[string]string{"error": errCode}
+	if description != "" {
+		body["error_description"] = description
+	}
+	writeJSON(w, status, body)
+}
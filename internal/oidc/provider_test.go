@@ -0,0 +1,240 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/mfa"
+	"github.com/dlshle/authnz/internal/oidc"
+	"github.com/dlshle/authnz/internal/sod"
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+)
+
+const (
+	issuer      = "https://authnz.example"
+	clientID    = "app"
+	redirectURI = "https://app.example/callback"
+	password    = "correct horse battery"
+	verifier    = "a-code-verifier-that-is-long-enough-for-pkce-0123456789"
+)
+
+type fixture struct {
+	routes    http.Handler
+	provider  *oidc.Provider
+	subjectID string
+	secret    string
+	step      int64
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ctx := context.Background()
+	db := store.NewMemoryDB()
+	subjectStore, groupStore, contractStore := subject.NewMemoryStore(db), group.NewMemoryStore(db), contract.NewMemoryStore(db)
+	guard := sod.NewGuard(sod.NewMemoryStore(db), groupStore, contractStore)
+	subjectHandler := subject.NewHandler(subjectStore, contractStore, groupStore, guard)
+	groupHandler := group.NewHandler(groupStore, contractStore)
+	contractHandler := contract.NewHandler(contractStore, guard, time.Hour)
+	mfaHandler := mfa.NewHandler(mfa.NewMemoryStore(db), subjectStore, "", 0)
+
+	added, err := subjectHandler.AddSubject(ctx, &pb.AddSubjectRequest{UserId: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subjectID := added.Subject.Id
+	if _, err = subjectHandler.SetPassword(ctx, subjectID, password); err != nil {
+		t.Fatal(err)
+	}
+	parent, err := groupHandler.CreateGroup(ctx, &pb.Group{Attributes: []*pb.Attribute{{Key: "department", Value: "engineering"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := groupHandler.CreateGroup(ctx, &pb.Group{Attributes: []*pb.Attribute{{Key: "team", Value: "api"}}, ParentIds: []string{parent.Group.Id}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = contractHandler.CreateContract(ctx, &pb.Contract{SubjectId: subjectID, GroupId: child.Group.Id}); err != nil {
+		t.Fatal(err)
+	}
+
+	enrollment, err := mfaHandler.EnrollTOTP(ctx, subjectID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the previous step confirms the enrollment, logins use the current and the next one
+	step := mfa.TimeStep(time.Now())
+	if _, err = mfaHandler.ConfirmTOTP(ctx, subjectID, code(t, enrollment.Secret, step-1)); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := oidc.LoadSigningKey("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := oidc.NewProvider(oidc.NewMemoryStore(db), key, oidc.Options{
+		Issuer:  issuer,
+		Clients: []oidc.Client{{ID: clientID, RedirectURIs: []string{redirectURI}}},
+	}, subjectHandler, mfaHandler, contractHandler, groupHandler)
+	return &fixture{routes: provider.Routes(), provider: provider, subjectID: subjectID, secret: enrollment.Secret, step: step}
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	f := newFixture(t)
+
+	// a login without the password is refused even with a valid code
+	resp := f.login("", code(t, f.secret, f.step))
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatalf("login without password: expected 401, got %d", resp.Code)
+	}
+	resp = f.login("wrong password!", code(t, f.secret, f.step))
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatalf("login with wrong password: expected 401, got %d", resp.Code)
+	}
+
+	authCode := f.authorize(t, code(t, f.secret, f.step))
+	// the code is consumed by a failed exchange as well
+	if resp = f.exchange(authCode, "another-verifier-that-does-not-match-the-challenge"); resp.Code != http.StatusBadRequest {
+		t.Fatalf("exchange with wrong verifier: expected 400, got %d", resp.Code)
+	}
+	if resp = f.exchange(authCode, verifier); resp.Code != http.StatusBadRequest {
+		t.Fatalf("exchange of a consumed code: expected 400, got %d", resp.Code)
+	}
+
+	authCode = f.authorize(t, code(t, f.secret, f.step+1))
+	resp = f.exchange(authCode, verifier)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("exchange: expected 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	tokens := map[string]interface{}{}
+	if err := json.Unmarshal(resp.Body.Bytes(), &tokens); err != nil {
+		t.Fatal(err)
+	}
+	accessToken, _ := tokens["access_token"].(string)
+	if subjectID, err := f.provider.VerifyAccessToken(accessToken); err != nil || subjectID != f.subjectID {
+		t.Fatalf("access token: expected subject %s, got %s (%v)", f.subjectID, subjectID, err)
+	}
+	if resp = f.exchange(authCode, verifier); resp.Code != http.StatusBadRequest {
+		t.Fatalf("replayed exchange: expected 400, got %d", resp.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, oidc.UserInfoPath, nil)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	resp = httptest.NewRecorder()
+	f.routes.ServeHTTP(resp, req)
+	userInfo := map[string]interface{}{}
+	if err := json.Unmarshal(resp.Body.Bytes(), &userInfo); err != nil {
+		t.Fatal(err)
+	}
+	if userInfo["sub"] != f.subjectID || userInfo["team"] != "api" || userInfo["department"] != "engineering" {
+		t.Fatalf("userinfo: expected own and inherited attributes, got %v", userInfo)
+	}
+}
+
+func TestAuthorizeRequiresS256(t *testing.T) {
+	f := newFixture(t)
+	for name, params := range map[string]url.Values{
+		"missing challenge": authorizeParams(""),
+		"plain challenge":   withParam(authorizeParams(verifier), "code_challenge_method", "plain"),
+	} {
+		req := httptest.NewRequest(http.MethodGet, oidc.AuthorizePath+"?"+params.Encode(), nil)
+		resp := httptest.NewRecorder()
+		f.routes.ServeHTTP(resp, req)
+		location, err := url.Parse(resp.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Code != http.StatusFound || location.Query().Get("error") != "invalid_request" {
+			t.Fatalf("%s: expected an invalid_request redirect, got %d %s", name, resp.Code, location)
+		}
+	}
+}
+
+func (f *fixture) login(password, otp string) *httptest.ResponseRecorder {
+	form := authorizeParams(challenge(verifier))
+	form.Set("username", "alice")
+	form.Set("password", password)
+	form.Set("otp", otp)
+	req := httptest.NewRequest(http.MethodPost, oidc.AuthorizePath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := httptest.NewRecorder()
+	f.routes.ServeHTTP(resp, req)
+	return resp
+}
+
+// authorize signs in and returns the authorization code of the redirect
+func (f *fixture) authorize(t *testing.T, otp string) string {
+	t.Helper()
+	resp := f.login(password, otp)
+	if resp.Code != http.StatusFound {
+		t.Fatalf("login: expected 302, got %d: %s", resp.Code, resp.Body.String())
+	}
+	location, err := url.Parse(resp.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.Query().Get("state") != "xyz" || location.Query().Get("code") == "" {
+		t.Fatalf("login: expected a code and the state in the redirect, got %s", location)
+	}
+	return location.Query().Get("code")
+}
+
+func (f *fixture) exchange(authCode, codeVerifier string) *httptest.ResponseRecorder {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {clientID},
+		"code":          {authCode},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {codeVerifier},
+	}
+	req := httptest.NewRequest(http.MethodPost, oidc.TokenPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := httptest.NewRecorder()
+	f.routes.ServeHTTP(resp, req)
+	return resp
+}
+
+func authorizeParams(codeChallenge string) url.Values {
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {"openid"},
+		"state":                 {"xyz"},
+		"code_challenge_method": {"S256"},
+	}
+	if codeChallenge != "" {
+		params.Set("code_challenge", codeChallenge)
+	}
+	return params
+}
+
+func withParam(params url.Values, key, value string) url.Values {
+	params.Set(key, value)
+	return params
+}
+
+func challenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func code(t *testing.T, secret string, step int64) string {
+	t.Helper()
+	otp, err := mfa.GenerateCode(secret, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return otp
+}
@@ -0,0 +1,50 @@
+package oidc
+
+import (
+	"time"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/dlshle/gommon/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	PutCode(code *AuthorizationCode) error
+	// ConsumeCode deletes and returns the code so it can not be redeemed twice
+	ConsumeCode(codeHash string) (*AuthorizationCode, error)
+	DeleteExpiredCodes() error
+}
+
+type sqlStore struct {
+	db *sqlx.DB
+}
+
+func NewSQLStore(db *sqlx.DB) Store {
+	return &sqlStore{db: db}
+}
+
+func (s *sqlStore) PutCode(code *AuthorizationCode) error {
+	res, err := s.db.Exec("INSERT INTO oidc_authorization_codes (code_hash, client_id, subject_id, redirect_uri, scope, nonce, code_challenge, code_challenge_method, auth_time, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+		code.CodeHash, code.ClientID, code.SubjectID, code.RedirectURI, code.Scope, code.Nonce, code.CodeChallenge, code.CodeChallengeMethod, code.AuthTime, code.ExpiresAt)
+	if err != nil {
+		return store.TranslateError(err, "authorization code for "+code.SubjectID)
+	}
+	return store.CheckErrorForRowsAffected(res, "authorization code is not inserted for client "+code.ClientID)
+}
+
+func (s *sqlStore) ConsumeCode(codeHash string) (*AuthorizationCode, error) {
+	codes := []AuthorizationCode{}
+	err := s.db.Select(&codes, "DELETE FROM oidc_authorization_codes WHERE code_hash = $1 RETURNING *", codeHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(codes) == 0 {
+		return nil, errors.Error("authorization code not found")
+	}
+	return &codes[0], nil
+}
+
+func (s *sqlStore) DeleteExpiredCodes() error {
+	_, err := s.db.Exec("DELETE FROM oidc_authorization_codes WHERE expires_at < $1", time.Now())
+	return err
+}
@@ -0,0 +1,40 @@
+package oidc
+
+import "time"
+
+/*
+ * An authorization code is issued by the authorize endpoint and exchanged exactly once at the token endpoint.
+ * Only the hash of the code is persisted.
+ */
+
+type AuthorizationCode struct {
+	CodeHash            string    `db:"code_hash"`
+	ClientID            string    `db:"client_id"`
+	SubjectID           string    `db:"subject_id"`
+	RedirectURI         string    `db:"redirect_uri"`
+	Scope               string    `db:"scope"`
+	Nonce               string    `db:"nonce"`
+	CodeChallenge       string    `db:"code_challenge"`
+	CodeChallengeMethod string    `db:"code_challenge_method"`
+	AuthTime            time.Time `db:"auth_time"`
+	ExpiresAt           time.Time `db:"expires_at"`
+}
+
+type Client struct {
+	ID           string
+	Secret       string
+	RedirectURIs []string
+}
+
+func (c Client) IsPublic() bool {
+	return c.Secret == ""
+}
+
+func (c Client) HasRedirectURI(redirectURI string) bool {
+	for _, uri := range c.RedirectURIs {
+		if uri == redirectURI {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package policy
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/dlshle/gommon/errors"
 	"github.com/dlshle/gommon/logging"
@@ -12,10 +13,10 @@ import (
 )
 
 type Engine interface {
-	Check(policy *pb.Policy, group *pb.Group, ctx *pb.ContextProperty) (pb.Verdict, error)
+	Check(policy *pb.Policy, group *pb.Group, ctx *pb.AuthContext) (pb.Verdict, error)
 }
 
-type conditionProcessor = func(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (pb.Verdict, error)
+type conditionProcessor = func(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (pb.Verdict, error)
 
 type engine struct {
 	conditionProcessors []conditionProcessor
@@ -37,17 +38,20 @@ func (e *engine) init() {
 		e.NegationProcessor,
 		e.AndProcessor,
 		e.OrProcessor,
+		e.MFAAuthenticatedProcessor,
+		e.ResourceAttributeProcessor,
+		e.ResourceMatchesGroupAttributeProcessor,
 	}
 }
 
-func (e *engine) Check(policy *pb.Policy, pbGroup *pb.Group, ctx *pb.ContextProperty) (pb.Verdict, error) {
+func (e *engine) Check(policy *pb.Policy, pbGroup *pb.Group, ctx *pb.AuthContext) (pb.Verdict, error) {
 	if cond := policy.GetCondition(); cond != nil {
 		return e.evaluateCondition(cond, group.FromPB(pbGroup), ctx)
 	}
 	return pb.Verdict_UNKNOWN, errors.Error("empty condition for policy " + policy.GetId())
 }
 
-func (e *engine) evaluateCondition(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (verdict pb.Verdict, err error) {
+func (e *engine) evaluateCondition(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
 	verdict = pb.Verdict_UNKNOWN
 	for _, processor := range e.conditionProcessors {
 		verdict, err = processor(cond, group, ctx)
@@ -62,7 +66,7 @@ func (e *engine) evaluateCondition(cond *pb.PolicyCondition, group group.Group,
 	return
 }
 
-func (e *engine) HasAttributeProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (pb.Verdict, error) {
+func (e *engine) HasAttributeProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (pb.Verdict, error) {
 	hasAttributeCond := cond.GetHasAttribute()
 	if hasAttributeCond == nil {
 		return pb.Verdict_UNKNOWN, nil
@@ -75,7 +79,7 @@ func (e *engine) HasAttributeProcessor(cond *pb.PolicyCondition, group group.Gro
 	return pb.Verdict_PERMITTED, nil
 }
 
-func (e *engine) EvaluateOPProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (verdict pb.Verdict, err error) {
+func (e *engine) EvaluateOPProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
 	evaluateCond := cond.GetEvaluateAttribute()
 	if evaluateCond == nil {
 		return pb.Verdict_UNKNOWN, nil
@@ -110,7 +114,7 @@ func evalOp(op pb.Operation, attribute, value string) bool {
 	}
 }
 
-func (e *engine) NegationProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (verdict pb.Verdict, err error) {
+func (e *engine) NegationProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
 	negationCond := cond.GetNegation()
 	if negationCond == nil {
 		return pb.Verdict_UNKNOWN, nil
@@ -132,7 +136,7 @@ func (e *engine) NegationProcessor(cond *pb.PolicyCondition, group group.Group,
 	return
 }
 
-func (e *engine) AndProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (verdict pb.Verdict, err error) {
+func (e *engine) AndProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
 	andCond := cond.GetAnd()
 	if andCond == nil {
 		return pb.Verdict_UNKNOWN, nil
@@ -147,7 +151,7 @@ func (e *engine) AndProcessor(cond *pb.PolicyCondition, group group.Group, ctx *
 	return pb.Verdict_PERMITTED, nil
 }
 
-func (e *engine) OrProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.ContextProperty) (verdict pb.Verdict, err error) {
+func (e *engine) OrProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
 	orCond := cond.GetAnd()
 	if orCond == nil {
 		return pb.Verdict_UNKNOWN, nil
@@ -160,4 +164,73 @@ func (e *engine) OrProcessor(cond *pb.PolicyCondition, group group.Group, ctx *p
 	return pb.Verdict_PERMITTED, nil
 }
 
+func (e *engine) MFAAuthenticatedProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
+	mfaCond := cond.GetMfaAuthenticated()
+	if mfaCond == nil {
+		return pb.Verdict_UNKNOWN, nil
+	}
+	if !ctx.GetMfaAuthenticated() {
+		return pb.Verdict_DENIED, nil
+	}
+	if maxAge := mfaCond.GetMaxAgeSeconds(); maxAge > 0 && time.Now().Unix()-ctx.GetMfaAuthenticatedAt() > maxAge {
+		return pb.Verdict_DENIED, nil
+	}
+	return pb.Verdict_PERMITTED, nil
+}
+
+func (e *engine) ResourceAttributeProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
+	resourceCond := cond.GetResourceAttribute()
+	if resourceCond == nil {
+		return pb.Verdict_UNKNOWN, nil
+	}
+	attribute, exists := resourceAttribute(ctx.GetResource(), resourceCond.GetAttributeKey())
+	if exists && evalOp(resourceCond.GetOp(), attribute, resourceCond.GetValue()) {
+		return pb.Verdict_PERMITTED, nil
+	}
+	return pb.Verdict_DENIED, nil
+}
+
+func (e *engine) ResourceMatchesGroupAttributeProcessor(cond *pb.PolicyCondition, group group.Group, ctx *pb.AuthContext) (verdict pb.Verdict, err error) {
+	matchCond := cond.GetResourceMatchesGroupAttribute()
+	if matchCond == nil {
+		return pb.Verdict_UNKNOWN, nil
+	}
+	resourceValue, exists := resourceAttribute(ctx.GetResource(), matchCond.GetResourceAttributeKey())
+	if !exists {
+		return pb.Verdict_DENIED, nil
+	}
+	groupValue, exists := group.Attributes[matchCond.GetGroupAttributeKey()]
+	if exists && evalOp(matchCond.GetOp(), resourceValue, groupValue) {
+		return pb.Verdict_PERMITTED, nil
+	}
+	return pb.Verdict_DENIED, nil
+}
+
+func resourceAttribute(resource *pb.Resource, key string) (string, bool) {
+	for _, attribute := range resource.GetAttributes() {
+		if attribute.GetKey() == key {
+			return attribute.GetValue(), true
+		}
+	}
+	return "", false
+}
+
+// MatchesTarget tells if policy has a target matching action and resourceType
+func MatchesTarget(policy *pb.Policy, action, resourceType string) bool {
+	target := policy.GetTarget()
+	return target != nil && matchesAny(target.GetActions(), action) && matchesAny(target.GetResourceTypes(), resourceType)
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // TODO: other processors
@@ -35,6 +35,11 @@ func (h *Handler) DeletePolicy(ctx context.Context, policyID string) (*pb.EmptyR
 	return &pb.EmptyResponse{}, err
 }
 
-func (h *Handler) GetPolicyByID(policyID string) (*pb.Policy, error) {
-	return h.store.Get(policyID)
+// GetPoliciesByTarget returns the policies applying to action on resources of resourceType
+func (h *Handler) GetPoliciesByTarget(ctx context.Context, action, resourceType string) ([]*pb.Policy, error) {
+	return h.store.ListByTarget(ctx, action, resourceType)
+}
+
+func (h *Handler) GetPolicyByID(ctx context.Context, policyID string) (*pb.Policy, error) {
+	return h.store.Get(ctx, policyID)
 }
@@ -1,46 +1,70 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
 	"github.com/dlshle/gommon/utils"
 	"github.com/gofrs/uuid"
-	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 )
 
 type Store interface {
-	Get(id string) (*pb.Policy, error)
+	// Get may read from a read replica when the store has any
+	Get(ctx context.Context, id string) (*pb.Policy, error)
 	Delete(id string) error
 	Put(policy *pb.Policy) (*pb.Policy, error)
+	// ListByTarget returns the policies whose target matches action and resourceType, it may read from a read replica
+	ListByTarget(ctx context.Context, action, resourceType string) ([]*pb.Policy, error)
 }
 
-type SQLPolicyStore struct {
+// PBPolicyStore stores policies as entities, only the sql backends index their targets
+type PBPolicyStore struct {
 	pbEntityStore store.PBEntityStore
+	format        store.PayloadFormat
+	// sql stores keep the targets of policies in the indexed policy_targets table
+	indexTargets bool
 }
 
-func NewSQLStore(db *sqlx.DB) Store {
-	return &SQLPolicyStore{pbEntityStore: store.NewSQLPBEntityStore(db, "policies")}
+func NewSQLStore(db *sqlx.DB, format store.PayloadFormat) Store {
+	return &PBPolicyStore{pbEntityStore: store.NewSQLPBEntityStore(db, "policies"), format: format, indexTargets: true}
 }
 
-func (s *SQLPolicyStore) Get(id string) (*pb.Policy, error) {
-	pbEntity, err := s.pbEntityStore.Get(id)
+func NewReplicatedSQLStore(replicas *store.ReplicaSet, format store.PayloadFormat) Store {
+	return &PBPolicyStore{pbEntityStore: store.NewReplicatedSQLPBEntityStore(replicas, "policies"), format: format, indexTargets: true}
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &PBPolicyStore{pbEntityStore: store.NewKVPBEntityStore(db, "policies")}
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &PBPolicyStore{pbEntityStore: store.NewMemoryPBEntityStore(db, "policies")}
+}
+
+func (s *PBPolicyStore) Get(ctx context.Context, id string) (*pb.Policy, error) {
+	var pbEntity *store.PBEntity
+	err := s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		pbEntity, err = s.pbEntityStore.TxGet(tx, id)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 	policy := &pb.Policy{}
-	err = proto.Unmarshal(pbEntity.Payload, policy)
+	err = store.DecodePBEntity(pbEntity, policy)
 	return policy, err
 }
 
-func (s *SQLPolicyStore) Put(policy *pb.Policy) (ret *pb.Policy, err error) {
+func (s *PBPolicyStore) Put(policy *pb.Policy) (ret *pb.Policy, err error) {
 	var (
-		payload  []byte
 		pbEntity *store.PBEntity
+		put      *store.PBEntity
 	)
 	err = utils.ProcessWithErrors(func() error {
 		if policy.Id == "" {
@@ -52,22 +76,99 @@ func (s *SQLPolicyStore) Put(policy *pb.Policy) (ret *pb.Policy, err error) {
 		}
 		return nil
 	}, func() error {
-		payload, err = proto.Marshal(policy)
+		pbEntity, err = store.EncodePBEntity(policy.Id, policy, s.format)
 		return err
 	}, func() error {
-		pbEntity, err = s.pbEntityStore.Put(&store.PBEntity{ID: policy.Id, Payload: payload})
-		return err
+		return s.pbEntityStore.WithTx(context.Background(), func(tx store.SQLTransactional) (err error) {
+			// TxPut sets the version of the entity it is given, a retried transaction starts from the encoded one
+			attempt := *pbEntity
+			if put, err = s.pbEntityStore.TxPut(tx, &attempt); err != nil || !s.indexTargets {
+				return err
+			}
+			return TxPutTargets(tx, policy)
+		})
 	}, func() error {
-		policy.Id = pbEntity.ID
+		policy.Id = put.ID
+		policy.Version = put.Version
 		return nil
 	})
 	return policy, err
 }
 
-func (s *SQLPolicyStore) Delete(id string) error {
+// TxPutTargets replaces the policy_targets rows of the policy with one row per action and resource type of its
+// target, an empty list matches any value and is stored as ”. Policies without a target have no rows.
+func TxPutTargets(tx store.SQLTransactional, policy *pb.Policy) error {
+	if _, err := tx.Exec("DELETE FROM policy_targets WHERE policy_id = $1", policy.Id); err != nil {
+		return err
+	}
+	target := policy.GetTarget()
+	if target == nil {
+		return nil
+	}
+	actions, resourceTypes := target.GetActions(), target.GetResourceTypes()
+	if len(actions) == 0 {
+		actions = []string{""}
+	}
+	if len(resourceTypes) == 0 {
+		resourceTypes = []string{""}
+	}
+	for _, action := range actions {
+		for _, resourceType := range resourceTypes {
+			_, err := tx.Exec("INSERT INTO policy_targets (policy_id, action, resource_type) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+				policy.Id, action, resourceType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *PBPolicyStore) Delete(id string) error {
 	return s.pbEntityStore.Delete(id)
 }
 
+func (s *PBPolicyStore) ListByTarget(ctx context.Context, action, resourceType string) ([]*pb.Policy, error) {
+	entities, err := s.findByTarget(ctx, action, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	policies := []*pb.Policy{}
+	for _, entity := range entities {
+		policy := &pb.Policy{}
+		if err = store.DecodePBEntity(entity, policy); err != nil {
+			return nil, err
+		}
+		if MatchesTarget(policy, action, resourceType) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+// findByTarget returns a superset of the policies matching action and resourceType
+func (s *PBPolicyStore) findByTarget(ctx context.Context, action, resourceType string) (entities []*store.PBEntity, err error) {
+	err = s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) error {
+		if !s.indexTargets {
+			// every document with a target
+			containment, err := protojson.Marshal(&pb.Policy{Target: &pb.PolicyTarget{}})
+			if err != nil {
+				return err
+			}
+			entities, err = s.pbEntityStore.TxFindByDocument(tx, containment)
+			return err
+		}
+		rows := []store.PBEntity{}
+		err := tx.Select(&rows, "SELECT id, payload, document, version FROM policies WHERE id IN (SELECT policy_id FROM policy_targets WHERE action IN ($1, '') AND resource_type IN ($2, ''))",
+			action, resourceType)
+		for i := range rows {
+			entities = append(entities, &rows[i])
+		}
+		return err
+	})
+	return entities, err
+}
+
 type Person struct {
 	FirstName string `db:"first_name"`
 	LastName  string `db:"last_name"`
@@ -0,0 +1,225 @@
+package relation
+
+import (
+	"context"
+	"strconv"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+)
+
+// maxDepth bounds the rewrites and subject sets followed by a single check or expansion
+const maxDepth = 32
+
+type Handler struct {
+	store      Store
+	namespaces *Namespaces
+}
+
+func NewHandler(store Store, namespaces *Namespaces) *Handler {
+	return &Handler{store: store, namespaces: namespaces}
+}
+
+func (h *Handler) WriteTuples(ctx context.Context, writes, deletes []*pb.RelationTuple) (*pb.EmptyResponse, error) {
+	toWrite, err := h.fromPB(writes)
+	if err != nil {
+		return nil, err
+	}
+	toDelete, err := h.fromPB(deletes)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyResponse{}, h.store.Write(ctx, toWrite, toDelete)
+}
+
+func (h *Handler) fromPB(pbTuples []*pb.RelationTuple) ([]Tuple, error) {
+	tuples := make([]Tuple, len(pbTuples), len(pbTuples))
+	for i, pbTuple := range pbTuples {
+		tuple, err := FromPB(pbTuple)
+		if err != nil {
+			return nil, err
+		}
+		if err = h.namespaces.validate(tuple); err != nil {
+			return nil, err
+		}
+		tuples[i] = tuple
+	}
+	return tuples, nil
+}
+
+// Check tells if subjectID has relation on object through direct tuples, subject sets or the rewrites of the relation
+func (h *Handler) Check(ctx context.Context, object *pb.RelationObject, relation, subjectID string) (pb.Verdict, error) {
+	if _, ok := h.namespaces.rewrite(object.GetNamespace(), relation); !ok {
+		return pb.Verdict_UNKNOWN, errors.Error("relation " + object.GetNamespace() + "#" + relation + " is not declared")
+	}
+	w := &walker{ctx: ctx, store: h.store, namespaces: h.namespaces, visiting: make(map[string]bool)}
+	permitted, err := w.check(object.GetNamespace(), object.GetId(), relation, subjectID, 0)
+	if err != nil {
+		return pb.Verdict_UNKNOWN, err
+	}
+	if permitted {
+		return pb.Verdict_PERMITTED, nil
+	}
+	return pb.Verdict_DENIED, nil
+}
+
+// Expand returns the userset tree of relation on object, subject sets of direct tuples are left for the caller
+// to expand
+func (h *Handler) Expand(ctx context.Context, object *pb.RelationObject, relation string) (*pb.RelationTree, error) {
+	if _, ok := h.namespaces.rewrite(object.GetNamespace(), relation); !ok {
+		return nil, errors.Error("relation " + object.GetNamespace() + "#" + relation + " is not declared")
+	}
+	w := &walker{ctx: ctx, store: h.store, namespaces: h.namespaces, visiting: make(map[string]bool)}
+	return w.expand(object.GetNamespace(), object.GetId(), relation, 0)
+}
+
+// walker follows the rewrites of one check or expansion, usersets already on the current path are skipped
+// so that cyclic tuples, e.g. a folder being its own ancestor, terminate
+type walker struct {
+	ctx        context.Context
+	store      Store
+	namespaces *Namespaces
+	visiting   map[string]bool
+}
+
+func (w *walker) enter(namespace, objectID, relation string, depth int) (*rewrite, bool, error) {
+	if depth > maxDepth {
+		return nil, false, errors.Error("relation " + userset(namespace, objectID, relation) + " exceeds the maximum depth of " + strconv.Itoa(maxDepth))
+	}
+	compiled, ok := w.namespaces.rewrite(namespace, relation)
+	if !ok || w.visiting[userset(namespace, objectID, relation)] {
+		return nil, false, nil
+	}
+	w.visiting[userset(namespace, objectID, relation)] = true
+	return compiled, true, nil
+}
+
+func (w *walker) leave(namespace, objectID, relation string) {
+	delete(w.visiting, userset(namespace, objectID, relation))
+}
+
+func (w *walker) check(namespace, objectID, relation, subjectID string, depth int) (bool, error) {
+	compiled, ok, err := w.enter(namespace, objectID, relation, depth)
+	if !ok || err != nil {
+		return false, err
+	}
+	defer w.leave(namespace, objectID, relation)
+	return w.checkRewrite(namespace, objectID, relation, subjectID, compiled, depth)
+}
+
+func (w *walker) checkRewrite(namespace, objectID, relation, subjectID string, compiled *rewrite, depth int) (bool, error) {
+	switch {
+	case compiled.this:
+		tuples, err := w.store.List(w.ctx, namespace, objectID, relation)
+		if err != nil {
+			return false, err
+		}
+		for _, tuple := range tuples {
+			if tuple.SubjectID != "" && tuple.SubjectID == subjectID {
+				return true, nil
+			}
+		}
+		for _, tuple := range tuples {
+			if tuple.SubjectNS == "" || tuple.SubjectRelation == "" {
+				continue
+			}
+			if permitted, err := w.check(tuple.SubjectNS, tuple.SubjectObjectID, tuple.SubjectRelation, subjectID, depth+1); permitted || err != nil {
+				return permitted, err
+			}
+		}
+	case compiled.computedUserset != "":
+		return w.check(namespace, objectID, compiled.computedUserset, subjectID, depth+1)
+	case compiled.tupleToUserset != nil:
+		tuples, err := w.store.List(w.ctx, namespace, objectID, compiled.tupleToUserset.tupleset)
+		if err != nil {
+			return false, err
+		}
+		for _, tuple := range tuples {
+			if tuple.SubjectNS == "" {
+				continue
+			}
+			if permitted, err := w.check(tuple.SubjectNS, tuple.SubjectObjectID, compiled.tupleToUserset.computedUserset, subjectID, depth+1); permitted || err != nil {
+				return permitted, err
+			}
+		}
+	default:
+		for _, child := range compiled.union {
+			if permitted, err := w.checkRewrite(namespace, objectID, relation, subjectID, child, depth+1); permitted || err != nil {
+				return permitted, err
+			}
+		}
+	}
+	return false, nil
+}
+
+func (w *walker) expand(namespace, objectID, relation string, depth int) (*pb.RelationTree, error) {
+	compiled, ok, err := w.enter(namespace, objectID, relation, depth)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return leaf(namespace, objectID, relation), nil
+	}
+	defer w.leave(namespace, objectID, relation)
+	return w.expandRewrite(namespace, objectID, relation, compiled, depth)
+}
+
+func (w *walker) expandRewrite(namespace, objectID, relation string, compiled *rewrite, depth int) (*pb.RelationTree, error) {
+	switch {
+	case compiled.this:
+		tuples, err := w.store.List(w.ctx, namespace, objectID, relation)
+		if err != nil {
+			return nil, err
+		}
+		tree := leaf(namespace, objectID, relation)
+		for _, tuple := range tuples {
+			if tuple.SubjectID != "" {
+				tree.SubjectIds = append(tree.SubjectIds, tuple.SubjectID)
+			} else {
+				tree.SubjectSets = append(tree.SubjectSets, tuple.subjectSet())
+			}
+		}
+		return tree, nil
+	case compiled.computedUserset != "":
+		return w.expand(namespace, objectID, compiled.computedUserset, depth+1)
+	case compiled.tupleToUserset != nil:
+		tuples, err := w.store.List(w.ctx, namespace, objectID, compiled.tupleToUserset.tupleset)
+		if err != nil {
+			return nil, err
+		}
+		tree := union(namespace, objectID, relation)
+		for _, tuple := range tuples {
+			if tuple.SubjectNS == "" {
+				continue
+			}
+			child, err := w.expand(tuple.SubjectNS, tuple.SubjectObjectID, compiled.tupleToUserset.computedUserset, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			tree.Children = append(tree.Children, child)
+		}
+		return tree, nil
+	default:
+		tree := union(namespace, objectID, relation)
+		for _, child := range compiled.union {
+			childTree, err := w.expandRewrite(namespace, objectID, relation, child, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			tree.Children = append(tree.Children, childTree)
+		}
+		return tree, nil
+	}
+}
+
+func leaf(namespace, objectID, relation string) *pb.RelationTree {
+	return &pb.RelationTree{Operation: pb.RelationTree_LEAF, Userset: usersetPB(namespace, objectID, relation)}
+}
+
+func union(namespace, objectID, relation string) *pb.RelationTree {
+	return &pb.RelationTree{Operation: pb.RelationTree_UNION, Userset: usersetPB(namespace, objectID, relation)}
+}
+
+func usersetPB(namespace, objectID, relation string) *pb.SubjectSet {
+	return &pb.SubjectSet{Object: &pb.RelationObject{Namespace: namespace, Id: objectID}, Relation: relation}
+}
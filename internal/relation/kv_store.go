@@ -0,0 +1,70 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+)
+
+// indexes the keys of tuples by the userset of their object relation
+const usersetIndex = "relation_tuples_userset_idx"
+
+type kvStore struct {
+	db *store.KVDB
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &kvStore{db: db}
+}
+
+func (s *kvStore) Write(ctx context.Context, writes, deletes []Tuple) error {
+	return s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		for _, tuple := range deletes {
+			if _, err = kvTx.Delete(tupleTable, tuple.String()); err != nil {
+				return err
+			}
+			if err = kvTx.RemoveIndex(usersetIndex, userset(tuple.Namespace, tuple.ObjectID, tuple.Relation), tuple.String()); err != nil {
+				return err
+			}
+		}
+		for _, tuple := range writes {
+			if err = kvTx.Put(tupleTable, tuple.String(), tuple); err != nil {
+				return err
+			}
+			if err = kvTx.AddIndex(usersetIndex, userset(tuple.Namespace, tuple.ObjectID, tuple.Relation), tuple.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvStore) List(ctx context.Context, namespace, objectID, relation string) (tuples []Tuple, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		keys, err := kvTx.Lookup(usersetIndex, userset(namespace, objectID, relation))
+		if err != nil {
+			return err
+		}
+		tuples = []Tuple{}
+		for _, key := range keys {
+			tuple := Tuple{}
+			found, err := kvTx.Get(tupleTable, key, &tuple)
+			if err != nil {
+				return err
+			}
+			if found {
+				tuples = append(tuples, tuple)
+			}
+		}
+		return nil
+	})
+	return
+}
@@ -0,0 +1,51 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+)
+
+const tupleTable = "relation_tuples"
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &memoryStore{db: db}
+}
+
+func (s *memoryStore) Write(ctx context.Context, writes, deletes []Tuple) error {
+	return s.db.WithTxContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		for _, tuple := range deletes {
+			memoryTx.Delete(tupleTable, tuple.String())
+		}
+		for _, tuple := range writes {
+			memoryTx.Put(tupleTable, tuple.String(), tuple)
+		}
+		return nil
+	})
+}
+
+func (s *memoryStore) List(ctx context.Context, namespace, objectID, relation string) (tuples []Tuple, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		tuples = []Tuple{}
+		for _, row := range memoryTx.Scan(tupleTable, func(row interface{}) bool {
+			tuple := row.(Tuple)
+			return tuple.Namespace == namespace && tuple.ObjectID == objectID && tuple.Relation == relation
+		}) {
+			tuples = append(tuples, row.(Tuple))
+		}
+		return nil
+	})
+	return
+}
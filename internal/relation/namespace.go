@@ -0,0 +1,122 @@
+package relation
+
+import (
+	"github.com/dlshle/authnz/internal/config"
+	"github.com/dlshle/gommon/errors"
+)
+
+// rewrite is a compiled RewriteConfig, exactly one of its fields is set
+type rewrite struct {
+	this            bool
+	computedUserset string
+	tupleToUserset  *tupleToUserset
+	union           []*rewrite
+}
+
+type tupleToUserset struct {
+	tupleset        string
+	computedUserset string
+}
+
+var thisRewrite = &rewrite{this: true}
+
+// Namespaces holds the relations of every namespace and how they are rewritten
+type Namespaces struct {
+	relations map[string]map[string]*rewrite
+}
+
+// NewNamespaces compiles the namespace configs, it rejects rewrites referring to relations that are not
+// declared in the namespace
+func NewNamespaces(configs []config.NamespaceConfig) (*Namespaces, error) {
+	namespaces := &Namespaces{relations: make(map[string]map[string]*rewrite)}
+	for _, namespaceConfig := range configs {
+		if namespaceConfig.Name == "" {
+			return nil, errors.Error("namespace name is required")
+		}
+		if _, ok := namespaces.relations[namespaceConfig.Name]; ok {
+			return nil, errors.Error("namespace " + namespaceConfig.Name + " is declared twice")
+		}
+		relations := make(map[string]*rewrite)
+		for _, relationConfig := range namespaceConfig.Relations {
+			if relationConfig.Name == "" {
+				return nil, errors.Error("namespace " + namespaceConfig.Name + " has a relation without name")
+			}
+			relations[relationConfig.Name] = thisRewrite
+		}
+		for _, relationConfig := range namespaceConfig.Relations {
+			if relationConfig.Rewrite == nil {
+				continue
+			}
+			compiled, err := compileRewrite(*relationConfig.Rewrite, relations)
+			if err != nil {
+				return nil, errors.Error("relation " + namespaceConfig.Name + "#" + relationConfig.Name + " has an invalid rewrite: " + err.Error())
+			}
+			relations[relationConfig.Name] = compiled
+		}
+		namespaces.relations[namespaceConfig.Name] = relations
+	}
+	return namespaces, nil
+}
+
+func compileRewrite(rewriteConfig config.RewriteConfig, relations map[string]*rewrite) (*rewrite, error) {
+	set := 0
+	compiled := &rewrite{}
+	if rewriteConfig.This {
+		set++
+		compiled.this = true
+	}
+	if rewriteConfig.ComputedUserset != "" {
+		set++
+		if _, ok := relations[rewriteConfig.ComputedUserset]; !ok {
+			return nil, errors.Error("computed_userset " + rewriteConfig.ComputedUserset + " is not a relation of the namespace")
+		}
+		compiled.computedUserset = rewriteConfig.ComputedUserset
+	}
+	if ttu := rewriteConfig.TupleToUserset; ttu != nil {
+		set++
+		if _, ok := relations[ttu.Tupleset]; !ok {
+			return nil, errors.Error("tupleset " + ttu.Tupleset + " is not a relation of the namespace")
+		}
+		if ttu.ComputedUserset == "" {
+			return nil, errors.Error("tuple_to_userset needs a computed_userset")
+		}
+		compiled.tupleToUserset = &tupleToUserset{tupleset: ttu.Tupleset, computedUserset: ttu.ComputedUserset}
+	}
+	if len(rewriteConfig.Union) > 0 {
+		set++
+		for _, child := range rewriteConfig.Union {
+			compiledChild, err := compileRewrite(child, relations)
+			if err != nil {
+				return nil, err
+			}
+			compiled.union = append(compiled.union, compiledChild)
+		}
+	}
+	if set != 1 {
+		return nil, errors.Error("exactly one of this, computed_userset, tuple_to_userset and union must be set")
+	}
+	return compiled, nil
+}
+
+// rewrite returns how relation of namespace is computed, it reports false for undeclared relations
+func (n *Namespaces) rewrite(namespace, relation string) (*rewrite, bool) {
+	compiled, ok := n.relations[namespace][relation]
+	return compiled, ok
+}
+
+// validate rejects tuples of undeclared relations and subject sets of undeclared namespaces or relations
+func (n *Namespaces) validate(tuple Tuple) error {
+	if _, ok := n.rewrite(tuple.Namespace, tuple.Relation); !ok {
+		return errors.Error("relation " + tuple.Namespace + "#" + tuple.Relation + " is not declared")
+	}
+	if tuple.SubjectNS == "" {
+		return nil
+	}
+	if _, ok := n.relations[tuple.SubjectNS]; !ok {
+		return errors.Error("namespace " + tuple.SubjectNS + " is not declared")
+	}
+	if _, ok := n.rewrite(tuple.SubjectNS, tuple.SubjectRelation); tuple.SubjectRelation != "" && !ok {
+		return errors.Error("relation " + tuple.SubjectNS + "#" + tuple.SubjectRelation + " is not declared")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	// Write deletes and then inserts tuples in one transaction, writing an existing tuple or deleting a
+	// missing one is a no-op
+	Write(ctx context.Context, writes, deletes []Tuple) error
+	// List returns the tuples of namespace:objectID#relation, it may read from a read replica when the store has any
+	List(ctx context.Context, namespace, objectID, relation string) ([]Tuple, error)
+}
+
+type tupleStore struct {
+	db       *sqlx.DB
+	replicas *store.ReplicaSet
+}
+
+func NewSQLStore(db *sqlx.DB) Store {
+	return &tupleStore{db: db}
+}
+
+// NewReplicatedSQLStore writes to the primary of replicas and serves List from its read replicas
+func NewReplicatedSQLStore(replicas *store.ReplicaSet) Store {
+	return &tupleStore{db: replicas.Primary(), replicas: replicas}
+}
+
+func (s *tupleStore) Write(ctx context.Context, writes, deletes []Tuple) error {
+	return store.WithSQLXTxContext(ctx, s.db, func(tx store.SQLTransactional) error {
+		for _, t := range deletes {
+			if _, err := tx.Exec("DELETE FROM relation_tuples WHERE namespace = $1 AND object_id = $2 AND relation = $3 AND subject_id = $4 AND subject_namespace = $5 AND subject_object_id = $6 AND subject_relation = $7",
+				t.Namespace, t.ObjectID, t.Relation, t.SubjectID, t.SubjectNS, t.SubjectObjectID, t.SubjectRelation); err != nil {
+				return err
+			}
+		}
+		for _, t := range writes {
+			if _, err := tx.Exec("INSERT INTO relation_tuples (namespace, object_id, relation, subject_id, subject_namespace, subject_object_id, subject_relation) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING",
+				t.Namespace, t.ObjectID, t.Relation, t.SubjectID, t.SubjectNS, t.SubjectObjectID, t.SubjectRelation); err != nil {
+				return store.TranslateError(err, "tuple "+t.String())
+			}
+		}
+		return nil
+	})
+}
+
+func (s *tupleStore) List(ctx context.Context, namespace, objectID, relation string) ([]Tuple, error) {
+	var reader store.SQLTransactional = s.db
+	if s.replicas != nil {
+		reader = s.replicas.Reader(ctx)
+	}
+	tuples := []Tuple{}
+	err := reader.Select(&tuples, "SELECT * FROM relation_tuples WHERE namespace = $1 AND object_id = $2 AND relation = $3", namespace, objectID, relation)
+	return tuples, err
+}
@@ -0,0 +1,84 @@
+package relation
+
+import (
+	"strings"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+)
+
+/*
+ * A tuple reads namespace:object_id#relation@subject where the subject is either a subject id or the
+ * subject set subject_namespace:subject_object_id#subject_relation
+ */
+
+type Tuple struct {
+	Namespace       string `db:"namespace"`
+	ObjectID        string `db:"object_id"`
+	Relation        string `db:"relation"`
+	SubjectID       string `db:"subject_id"`
+	SubjectNS       string `db:"subject_namespace"`
+	SubjectObjectID string `db:"subject_object_id"`
+	SubjectRelation string `db:"subject_relation"`
+}
+
+// reserved by the tuple notation, ids containing them would make tuple keys ambiguous
+const reservedChars = ":#@\x00"
+
+func FromPB(tuple *pb.RelationTuple) (Tuple, error) {
+	t := Tuple{
+		Namespace: tuple.GetObject().GetNamespace(),
+		ObjectID:  tuple.GetObject().GetId(),
+		Relation:  tuple.GetRelation(),
+		SubjectID: tuple.GetSubjectId(),
+	}
+	if set := tuple.GetSubjectSet(); set != nil {
+		t.SubjectNS = set.GetObject().GetNamespace()
+		t.SubjectObjectID = set.GetObject().GetId()
+		t.SubjectRelation = set.GetRelation()
+	}
+	if t.Namespace == "" || t.ObjectID == "" || t.Relation == "" {
+		return t, errors.Error("tuple " + t.String() + " needs an object and a relation")
+	}
+	if (t.SubjectID == "") == (t.SubjectNS == "") {
+		return t, errors.Error("tuple " + t.String() + " needs either a subject id or a subject set")
+	}
+	if t.SubjectNS != "" && t.SubjectObjectID == "" {
+		return t, errors.Error("tuple " + t.String() + " has a subject set without object id")
+	}
+	for _, part := range []string{t.Namespace, t.ObjectID, t.Relation, t.SubjectID, t.SubjectNS, t.SubjectObjectID, t.SubjectRelation} {
+		if strings.ContainsAny(part, reservedChars) {
+			return t, errors.Error("tuple " + t.String() + " contains one of the reserved characters : # @")
+		}
+	}
+	return t, nil
+}
+
+func (t Tuple) ToPB() *pb.RelationTuple {
+	tuple := &pb.RelationTuple{
+		Object:    &pb.RelationObject{Namespace: t.Namespace, Id: t.ObjectID},
+		Relation:  t.Relation,
+		SubjectId: t.SubjectID,
+	}
+	if t.SubjectNS != "" {
+		tuple.SubjectSet = t.subjectSet()
+	}
+	return tuple
+}
+
+func (t Tuple) subjectSet() *pb.SubjectSet {
+	return &pb.SubjectSet{Object: &pb.RelationObject{Namespace: t.SubjectNS, Id: t.SubjectObjectID}, Relation: t.SubjectRelation}
+}
+
+func (t Tuple) String() string {
+	subject := t.SubjectID
+	if t.SubjectNS != "" {
+		subject = userset(t.SubjectNS, t.SubjectObjectID, t.SubjectRelation)
+	}
+	return userset(t.Namespace, t.ObjectID, t.Relation) + "@" + subject
+}
+
+// userset is the namespace:object_id#relation notation, it also keys the tuples of an object relation
+func userset(namespace, objectID, relation string) string {
+	return namespace + ":" + objectID + "#" + relation
+}
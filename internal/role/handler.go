@@ -0,0 +1,86 @@
+package role
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+)
+
+type Handler struct {
+	store Store
+}
+
+func NewHandler(store Store) *Handler {
+	return &Handler{store: store}
+}
+
+func (h *Handler) CreateRole(ctx context.Context, role *pb.Role) (*pb.Role, error) {
+	if err := validate(role); err != nil {
+		return nil, err
+	}
+	return h.store.Put(role)
+}
+
+func (h *Handler) UpdateRole(ctx context.Context, role *pb.Role) (*pb.Role, error) {
+	if err := validate(role); err != nil {
+		return nil, err
+	}
+	return h.store.Put(role)
+}
+
+func (h *Handler) DeleteRole(ctx context.Context, roleID string) (*pb.EmptyResponse, error) {
+	err := h.store.Delete(roleID)
+	return &pb.EmptyResponse{}, err
+}
+
+func (h *Handler) GetRoleByID(ctx context.Context, roleID string) (*pb.Role, error) {
+	return h.store.Get(ctx, roleID)
+}
+
+// GrantingRoles returns the ids of the roles among roleIDs that grant permission, roles that no longer
+// exist grant nothing
+func (h *Handler) GrantingRoles(ctx context.Context, roleIDs []string, permission string) ([]string, error) {
+	roles, err := h.store.BulkGet(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	var granting []string
+	for _, role := range roles {
+		if Grants(role, permission) {
+			granting = append(granting, role.Id)
+		}
+	}
+	return granting, nil
+}
+
+// Grants tells if any permission of role matches permission. A permission ending with a "*" segment, like
+// "documents:*", matches every permission under its prefix and "*" alone matches all of them.
+func Grants(role *pb.Role, permission string) bool {
+	for _, granted := range role.Permissions {
+		if granted == permission || granted == "*" {
+			return true
+		}
+		if strings.HasSuffix(granted, ":*") && strings.HasPrefix(permission, strings.TrimSuffix(granted, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func validate(role *pb.Role) error {
+	if role.GetName() == "" {
+		return errors.Error("role name is required")
+	}
+	for _, permission := range role.Permissions {
+		if permission == "" {
+			return errors.Error("role " + role.Name + " has an empty permission")
+		}
+		wildcard := strings.TrimSuffix(strings.TrimSuffix(permission, ":*"), "*")
+		if strings.Contains(wildcard, "*") || (strings.HasSuffix(permission, "*") && wildcard != "" && !strings.HasSuffix(permission, ":*")) {
+			return errors.Error("permission " + permission + " may only use * as its last segment")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package role
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/utils"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	// Get and BulkGet may read from a read replica when the store has any
+	Get(ctx context.Context, id string) (*pb.Role, error)
+	// BulkGet skips the ids that are not found
+	BulkGet(ctx context.Context, ids []string) ([]*pb.Role, error)
+	Delete(id string) error
+	Put(role *pb.Role) (*pb.Role, error)
+}
+
+// PBRoleStore keeps roles as protobuf entities, the constructors pick the backend
+type PBRoleStore struct {
+	pbEntityStore store.PBEntityStore
+	format        store.PayloadFormat
+}
+
+func NewSQLStore(db *sqlx.DB, format store.PayloadFormat) Store {
+	return &PBRoleStore{pbEntityStore: store.NewSQLPBEntityStore(db, "roles"), format: format}
+}
+
+func NewReplicatedSQLStore(replicas *store.ReplicaSet, format store.PayloadFormat) Store {
+	return &PBRoleStore{pbEntityStore: store.NewReplicatedSQLPBEntityStore(replicas, "roles"), format: format}
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &PBRoleStore{pbEntityStore: store.NewKVPBEntityStore(db, "roles")}
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &PBRoleStore{pbEntityStore: store.NewMemoryPBEntityStore(db, "roles")}
+}
+
+func (s *PBRoleStore) Get(ctx context.Context, id string) (*pb.Role, error) {
+	var pbEntity *store.PBEntity
+	err := s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		pbEntity, err = s.pbEntityStore.TxGet(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	role := &pb.Role{}
+	err = store.DecodePBEntity(pbEntity, role)
+	return role, err
+}
+
+func (s *PBRoleStore) BulkGet(ctx context.Context, ids []string) ([]*pb.Role, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var entities []*store.PBEntity
+	err := s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		entities, err = s.pbEntityStore.TxBulkGet(tx, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*pb.Role, len(entities), len(entities))
+	for i, entity := range entities {
+		role := &pb.Role{}
+		if err = store.DecodePBEntity(entity, role); err != nil {
+			return nil, err
+		}
+		roles[i] = role
+	}
+	return roles, nil
+}
+
+func (s *PBRoleStore) Put(role *pb.Role) (ret *pb.Role, err error) {
+	var (
+		pbEntity *store.PBEntity
+	)
+	err = utils.ProcessWithErrors(func() error {
+		if role.Id == "" {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			role.Id = newID.String()
+		}
+		return nil
+	}, func() error {
+		pbEntity, err = store.EncodePBEntity(role.Id, role, s.format)
+		return err
+	}, func() error {
+		pbEntity, err = s.pbEntityStore.Put(pbEntity)
+		return err
+	}, func() error {
+		role.Id = pbEntity.ID
+		role.Version = pbEntity.Version
+		return nil
+	})
+	return role, err
+}
+
+func (s *PBRoleStore) Delete(id string) error {
+	return s.pbEntityStore.Delete(id)
+}
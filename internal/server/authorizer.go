@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/role"
+	"github.com/dlshle/authnz/internal/sod"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+)
+
+// Authorizer evaluates a policy against the merged groups of a subject, it backs both the Authorize rpc
+// and the authorization of authnz's own management rpcs. It also checks permissions granted by the roles
+// of these groups.
+type Authorizer struct {
+	contractHandler *contract.Handler
+	groupHandler    *group.Handler
+	policyHandler   *policy.Handler
+	roleHandler     *role.Handler
+	sodGuard        *sod.Guard
+}
+
+func NewAuthorizer(contractHandler *contract.Handler, groupHandler *group.Handler, policyHandler *policy.Handler, roleHandler *role.Handler, sodGuard *sod.Guard) *Authorizer {
+	return &Authorizer{contractHandler: contractHandler, groupHandler: groupHandler, policyHandler: policyHandler, roleHandler: roleHandler, sodGuard: sodGuard}
+}
+
+func (a *Authorizer) Check(ctx context.Context, policyID string, authCtx *pb.AuthContext) (pb.Verdict, error) {
+	verdict, _, err := a.CheckPolicy(ctx, policyID, authCtx)
+	return verdict, err
+}
+
+// CheckPolicy is Check that also returns the subject's break-glass contracts the decision was made with
+func (a *Authorizer) CheckPolicy(ctx context.Context, policyID string, authCtx *pb.AuthContext) (pb.Verdict, []string, error) {
+	engine := policy.NewEngine()
+	group, breakGlassContractIDs, err := a.subjectGroup(ctx, authCtx.GetSubjectId())
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, err
+	}
+	policy, err := a.policyHandler.GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, errors.Error("failed to get policy due to " + err.Error())
+	}
+	verdict, err := engine.Check(policy, group, authCtx)
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, err
+	}
+	if err = a.audit(ctx, authCtx.GetSubjectId(), "policy "+policyID, verdict, breakGlassContractIDs); err != nil {
+		return pb.Verdict_UNKNOWN, nil, err
+	}
+	return verdict, breakGlassContractIDs, nil
+}
+
+// CheckTarget checks the policies whose target matches the action and resource type of authCtx, it permits
+// only when at least one policy matches and all matching policies permit. The ids of the checked policies
+// and of the break-glass contracts the decision was made with are returned along with the verdict.
+func (a *Authorizer) CheckTarget(ctx context.Context, authCtx *pb.AuthContext) (pb.Verdict, []string, []string, error) {
+	policies, err := a.policyHandler.GetPoliciesByTarget(ctx, authCtx.GetAction(), authCtx.GetResource().GetType())
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, errors.Error("failed to get policies by target due to " + err.Error())
+	}
+	if len(policies) == 0 {
+		return pb.Verdict_DENIED, nil, nil, nil
+	}
+	group, breakGlassContractIDs, err := a.subjectGroup(ctx, authCtx.GetSubjectId())
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, err
+	}
+	engine := policy.NewEngine()
+	policyIDs := make([]string, len(policies), len(policies))
+	verdict := pb.Verdict_PERMITTED
+	for i, p := range policies {
+		policyIDs[i] = p.Id
+		policyVerdict, err := engine.Check(p, group, authCtx)
+		if err != nil {
+			return pb.Verdict_UNKNOWN, policyIDs[:i+1], nil, err
+		}
+		if policyVerdict != pb.Verdict_PERMITTED {
+			verdict = pb.Verdict_DENIED
+		}
+	}
+	check := "action " + authCtx.GetAction() + " on " + authCtx.GetResource().GetType()
+	if err = a.audit(ctx, authCtx.GetSubjectId(), check, verdict, breakGlassContractIDs); err != nil {
+		return pb.Verdict_UNKNOWN, policyIDs, nil, err
+	}
+	return verdict, policyIDs, breakGlassContractIDs, nil
+}
+
+// subjectGroup merges the groups of the subject including the attributes they inherit
+func (a *Authorizer) subjectGroup(ctx context.Context, subjectID string) (*pb.Group, []string, error) {
+	groups, breakGlassContractIDs, err := a.activeGroups(ctx, subjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if groups, err = a.groupHandler.ResolveInheritance(ctx, groups); err != nil {
+		return nil, nil, errors.Error("failed to resolve inherited attributes due to " + err.Error())
+	}
+	return group.MergeGroups(groups), breakGlassContractIDs, nil
+}
+
+// activeGroups returns the groups of the subject's contracts in effect and the ids of the break-glass contracts
+// among them, a *sod.ViolationError is returned as is when they break a separation of duties rule
+func (a *Authorizer) activeGroups(ctx context.Context, subjectID string) ([]*pb.Group, []string, error) {
+	memberships, err := a.contractHandler.GetMemberships(ctx, subjectID)
+	if err != nil {
+		return nil, nil, errors.Error("failed to get groups by subject due to " + err.Error())
+	}
+	groups := make([]*pb.Group, len(memberships), len(memberships))
+	var breakGlassContractIDs []string
+	for i, membership := range memberships {
+		groups[i] = membership.Group
+		if membership.BreakGlassContractID != "" {
+			breakGlassContractIDs = append(breakGlassContractIDs, membership.BreakGlassContractID)
+		}
+	}
+	if err = a.sodGuard.CheckGroups(ctx, subjectID, groups); err != nil {
+		if _, ok := err.(*sod.ViolationError); ok {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Error("failed to check separation of duties due to " + err.Error())
+	}
+	return groups, breakGlassContractIDs, nil
+}
+
+// audit keeps a record of a decision made with break-glass memberships, a decision that could not be recorded
+// is not returned
+func (a *Authorizer) audit(ctx context.Context, subjectID, check string, verdict pb.Verdict, breakGlassContractIDs []string) error {
+	if len(breakGlassContractIDs) == 0 {
+		return nil
+	}
+	if err := a.contractHandler.RecordBreakGlassDecision(ctx, subjectID, breakGlassContractIDs, check, verdict); err != nil {
+		return errors.Error("failed to record break-glass decision due to " + err.Error())
+	}
+	return nil
+}
+
+// CheckPermission permits when a role of the subject's groups, assigned directly or inherited from a parent
+// group, grants permission. The granting role ids and the break-glass contracts the decision was made with are
+// returned along with the verdict.
+func (a *Authorizer) CheckPermission(ctx context.Context, subjectID string, permission string) (pb.Verdict, []string, []string, error) {
+	groups, breakGlassContractIDs, err := a.activeGroups(ctx, subjectID)
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, err
+	}
+	if groups, err = a.groupHandler.ResolveInheritance(ctx, groups); err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, errors.Error("failed to resolve inherited roles due to " + err.Error())
+	}
+	var roleIDs []string
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		for _, roleID := range group.RoleIds {
+			if !seen[roleID] {
+				seen[roleID] = true
+				roleIDs = append(roleIDs, roleID)
+			}
+		}
+	}
+	granting, err := a.roleHandler.GrantingRoles(ctx, roleIDs, permission)
+	if err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, errors.Error("failed to get roles due to " + err.Error())
+	}
+	verdict := pb.Verdict_PERMITTED
+	if len(granting) == 0 {
+		verdict = pb.Verdict_DENIED
+	}
+	if err = a.audit(ctx, subjectID, "permission "+permission, verdict, breakGlassContractIDs); err != nil {
+		return pb.Verdict_UNKNOWN, nil, nil, err
+	}
+	return verdict, granting, breakGlassContractIDs, nil
+}
@@ -3,27 +3,47 @@ package server
 import (
 	"context"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/dlshle/authnz/internal/access"
+	"github.com/dlshle/authnz/internal/auth"
 	"github.com/dlshle/authnz/internal/config"
 	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/federation"
 	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/mfa"
 	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/relation"
+	"github.com/dlshle/authnz/internal/role"
+	"github.com/dlshle/authnz/internal/sod"
 	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/tlsutil"
 	pb "github.com/dlshle/authnz/proto"
 	"github.com/dlshle/gommon/errors"
 	"github.com/dlshle/gommon/logging"
 	"github.com/gofrs/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type server struct {
-	logger          logging.Logger
-	subjectHandler  *subject.Handler
-	groupHandler    *group.Handler
-	policyHandler   *policy.Handler
-	contractHandler *contract.Handler
+	logger            logging.Logger
+	subjectHandler    *subject.Handler
+	groupHandler      *group.Handler
+	policyHandler     *policy.Handler
+	roleHandler       *role.Handler
+	relationHandler   *relation.Handler
+	contractHandler   *contract.Handler
+	accessHandler     *access.Handler
+	sodHandler        *sod.Handler
+	mfaHandler        *mfa.Handler
+	federationHandler *federation.Handler
+	authorizer        *Authorizer
 	*pb.UnimplementedAuthNZServer
 }
 
@@ -31,29 +51,54 @@ func NewGRPCServer(
 	subjectHandler *subject.Handler,
 	groupHandler *group.Handler,
 	policyHandler *policy.Handler,
+	roleHandler *role.Handler,
+	relationHandler *relation.Handler,
 	contractHandler *contract.Handler,
+	accessHandler *access.Handler,
+	sodHandler *sod.Handler,
+	mfaHandler *mfa.Handler,
+	federationHandler *federation.Handler,
+	authorizer *Authorizer,
 ) pb.AuthNZServer {
 	return &server{
-		logger:          logging.GlobalLogger.WithPrefix("[GRPCServer]"),
-		subjectHandler:  subjectHandler,
-		groupHandler:    groupHandler,
-		policyHandler:   policyHandler,
-		contractHandler: contractHandler,
+		logger:            logging.GlobalLogger.WithPrefix("[GRPCServer]"),
+		subjectHandler:    subjectHandler,
+		groupHandler:      groupHandler,
+		policyHandler:     policyHandler,
+		roleHandler:       roleHandler,
+		relationHandler:   relationHandler,
+		contractHandler:   contractHandler,
+		accessHandler:     accessHandler,
+		sodHandler:        sodHandler,
+		mfaHandler:        mfaHandler,
+		federationHandler: federationHandler,
+		authorizer:        authorizer,
 	}
 }
 
 func (s *server) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
-	engine := policy.NewEngine()
-	groups, err := s.contractHandler.GetGroupsBySubjectID(req.SubjectId)
-	if err != nil {
-		return nil, errors.Error("failed to get groups by subject due to " + err.Error())
+	authCtx := &pb.AuthContext{SubjectId: req.SubjectId, ContextProperty: req.ContextProperty, Action: req.Action, Resource: req.Resource}
+	if req.MfaSessionId != "" {
+		session, err := s.mfaHandler.GetAuthenticatedSession(req.SubjectId, req.MfaSessionId)
+		if err != nil {
+			// an invalid session is treated as a non-mfa session instead of failing the request
+			s.logger.Warnf(ctx, "mfa session is not accepted due to %s", err.Error())
+		} else {
+			authCtx.MfaAuthenticated = true
+			authCtx.MfaAuthenticatedAt = session.AuthenticatedAt.Unix()
+		}
 	}
-	policy, err := s.policyHandler.GetPolicyByID(req.PolicyId)
-	if err != nil {
-		return nil, errors.Error("failed to get policy due to " + err.Error())
+	resp := &pb.AuthorizeResponse{}
+	var err error
+	if req.PolicyId == "" {
+		if req.Action == "" {
+			return nil, errors.Error("either a policy id or an action is required")
+		}
+		resp.Verdict, resp.PolicyIds, resp.BreakGlassContractIds, err = s.authorizer.CheckTarget(ctx, authCtx)
+	} else {
+		resp.Verdict, resp.BreakGlassContractIds, err = s.authorizer.CheckPolicy(ctx, req.PolicyId, authCtx)
 	}
-	verdict, err := engine.Check(policy, group.MergeGroups(groups), nil)
-	return &pb.AuthorizeResponse{Verdict: verdict}, err
+	return resp, err
 }
 
 func handleRequest[T any](logger logging.Logger, action string, ctx context.Context, reqStr string, handler func() (T, error)) (T, error) {
@@ -70,11 +115,15 @@ func (s *server) AddSubject(ctx context.Context, req *pb.AddSubjectRequest) (*pb
 }
 
 func (s *server) GetSubject(ctx context.Context, req *pb.SubjectIDRequest) (*pb.Subject, error) {
-	return s.subjectHandler.GetSubjectByID(req.SubjectId)
+	return s.subjectHandler.GetSubject(ctx, req.SubjectId)
+}
+
+func (s *server) SetPassword(ctx context.Context, req *pb.SetPasswordRequest) (*pb.EmptyResponse, error) {
+	return s.subjectHandler.SetPassword(ctx, req.SubjectId, req.Password)
 }
 
 func (s *server) FindSubjectsByUserID(ctx context.Context, req *pb.SubjectsByUserIDRequest) (*pb.SubjectsByUserIDResponse, error) {
-	pbSubjects, err := s.subjectHandler.FindSubjectsByUserID(req.UserId)
+	pbSubjects, err := s.subjectHandler.FindSubjectsByUserID(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +159,14 @@ func (s *server) GetGroupsBySubjectID(ctx context.Context, req *pb.SubjectIDRequ
 	return &pb.GroupsResponse{Groups: groups}, nil
 }
 
+func (s *server) ListGroupsByAttributes(ctx context.Context, req *pb.ListGroupsByAttributesRequest) (*pb.GroupsResponse, error) {
+	groups, err := s.groupHandler.ListGroupsByAttributes(ctx, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupsResponse{Groups: groups}, nil
+}
+
 func (s *server) UpdateGroup(ctx context.Context, req *pb.GroupRequest) (*pb.GroupResponse, error) {
 	return s.groupHandler.UpdateGroup(ctx, req.Group)
 }
@@ -122,12 +179,86 @@ func (s *server) DeleteGroup(ctx context.Context, req *pb.GroupByIDRequest) (*pb
 	return s.groupHandler.DeleteGroup(ctx, req.GroupId)
 }
 
+func (s *server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersRequest) (*pb.GroupMembersResponse, error) {
+	return s.contractHandler.ListGroupMembers(ctx, req.GroupId, int(req.PageSize), req.PageToken)
+}
+
+func (s *server) AddSubjectsToGroup(ctx context.Context, req *pb.AddSubjectsToGroupRequest) (*pb.GroupMembershipResponse, error) {
+	window, err := contract.WindowFromPB(req.NotBefore, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return s.subjectHandler.AddSubjectsToGroup(ctx, req.GroupId, req.SubjectIds, window)
+}
+
+func (s *server) RemoveSubjectsFromGroup(ctx context.Context, req *pb.RemoveSubjectsFromGroupRequest) (*pb.GroupMembershipResponse, error) {
+	return s.subjectHandler.RemoveSubjectsFromGroup(ctx, req.GroupId, req.SubjectIds)
+}
+
+func (s *server) AttachParentGroup(ctx context.Context, req *pb.GroupParentRequest) (*pb.GroupResponse, error) {
+	return s.groupHandler.AttachParent(ctx, req.GroupId, req.ParentId)
+}
+
+func (s *server) DetachParentGroup(ctx context.Context, req *pb.GroupParentRequest) (*pb.GroupResponse, error) {
+	return s.groupHandler.DetachParent(ctx, req.GroupId, req.ParentId)
+}
+
+func (s *server) GetEffectiveGroup(ctx context.Context, req *pb.GroupByIDRequest) (*pb.GroupResponse, error) {
+	return s.groupHandler.GetEffectiveGroup(ctx, req.GroupId)
+}
+
+func (s *server) AssignGroupRole(ctx context.Context, req *pb.GroupRoleRequest) (*pb.GroupResponse, error) {
+	// groups only reference existing roles, a role deleted later is ignored by checkPermission
+	if _, err := s.roleHandler.GetRoleByID(ctx, req.RoleId); err != nil {
+		return nil, err
+	}
+	return s.groupHandler.AssignRole(ctx, req.GroupId, req.RoleId)
+}
+
+func (s *server) UnassignGroupRole(ctx context.Context, req *pb.GroupRoleRequest) (*pb.GroupResponse, error) {
+	return s.groupHandler.UnassignRole(ctx, req.GroupId, req.RoleId)
+}
+
+func (s *server) CreateRole(ctx context.Context, req *pb.RoleRequest) (*pb.Role, error) {
+	return s.roleHandler.CreateRole(ctx, req.Role)
+}
+
+func (s *server) GetRole(ctx context.Context, req *pb.RoleByIDRequest) (*pb.Role, error) {
+	return s.roleHandler.GetRoleByID(ctx, req.RoleId)
+}
+
+func (s *server) UpdateRole(ctx context.Context, req *pb.RoleRequest) (*pb.Role, error) {
+	return s.roleHandler.UpdateRole(ctx, req.Role)
+}
+
+func (s *server) DeleteRole(ctx context.Context, req *pb.RoleByIDRequest) (*pb.EmptyResponse, error) {
+	return s.roleHandler.DeleteRole(ctx, req.RoleId)
+}
+
+func (s *server) CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.CheckPermissionResponse, error) {
+	verdict, roleIDs, breakGlassContractIDs, err := s.authorizer.CheckPermission(ctx, req.SubjectId, req.Permission)
+	return &pb.CheckPermissionResponse{Verdict: verdict, RoleIds: roleIDs, BreakGlassContractIds: breakGlassContractIDs}, err
+}
+
+func (s *server) WriteRelationTuples(ctx context.Context, req *pb.WriteRelationTuplesRequest) (*pb.EmptyResponse, error) {
+	return s.relationHandler.WriteTuples(ctx, req.Writes, req.Deletes)
+}
+
+func (s *server) CheckRelation(ctx context.Context, req *pb.CheckRelationRequest) (*pb.CheckRelationResponse, error) {
+	verdict, err := s.relationHandler.Check(ctx, req.Object, req.Relation, req.SubjectId)
+	return &pb.CheckRelationResponse{Verdict: verdict}, err
+}
+
+func (s *server) ExpandRelation(ctx context.Context, req *pb.ExpandRelationRequest) (*pb.RelationTree, error) {
+	return s.relationHandler.Expand(ctx, req.Object, req.Relation)
+}
+
 func (s *server) CreatePolicy(ctx context.Context, req *pb.PolicyRequest) (*pb.Policy, error) {
 	return s.policyHandler.CreatePolicy(ctx, req.Policy)
 }
 
 func (s *server) GetPolicy(ctx context.Context, req *pb.PolicyByIDRequest) (*pb.Policy, error) {
-	return s.policyHandler.GetPolicyByID(req.PolicyId)
+	return s.policyHandler.GetPolicyByID(ctx, req.PolicyId)
 }
 
 func (s *server) UpdatePolicy(ctx context.Context, req *pb.PolicyRequest) (*pb.Policy, error) {
@@ -142,25 +273,213 @@ func (s *server) CreateContract(ctx context.Context, req *pb.ContractRequest) (*
 	return s.contractHandler.CreateContract(ctx, req.Contract)
 }
 
+func (s *server) UpdateContractWindow(ctx context.Context, req *pb.ContractWindowRequest) (*pb.ContractResponse, error) {
+	return s.contractHandler.UpdateContractWindow(ctx, req.ContractId, req.NotBefore, req.ExpiresAt)
+}
+
+func (s *server) BreakGlass(ctx context.Context, req *pb.BreakGlassRequest) (*pb.ContractResponse, error) {
+	grantedBy := ""
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		grantedBy = principal.SubjectID
+	}
+	return s.contractHandler.BreakGlass(ctx, req.SubjectId, req.GroupId, req.Justification, grantedBy, time.Duration(req.DurationSeconds)*time.Second)
+}
+
 func (s *server) DeleteContract(ctx context.Context, req *pb.DeleteContractRequest) (*pb.EmptyResponse, error) {
 	return s.contractHandler.DeleteContract(ctx, req.ContractId)
 }
 
-func StartServer(serverCfg config.ServerConfig, server pb.AuthNZServer) error {
+func (s *server) CreateSoDRule(ctx context.Context, req *pb.SoDRuleRequest) (*pb.SoDRule, error) {
+	return s.sodHandler.CreateRule(ctx, req.Rule)
+}
+
+func (s *server) GetSoDRule(ctx context.Context, req *pb.SoDRuleByIDRequest) (*pb.SoDRule, error) {
+	return s.sodHandler.GetRuleByID(ctx, req.RuleId)
+}
+
+func (s *server) UpdateSoDRule(ctx context.Context, req *pb.SoDRuleRequest) (*pb.SoDRule, error) {
+	return s.sodHandler.UpdateRule(ctx, req.Rule)
+}
+
+func (s *server) DeleteSoDRule(ctx context.Context, req *pb.SoDRuleByIDRequest) (*pb.EmptyResponse, error) {
+	return s.sodHandler.DeleteRule(ctx, req.RuleId)
+}
+
+func (s *server) ListSoDRules(ctx context.Context, req *pb.ListSoDRulesRequest) (*pb.SoDRulesResponse, error) {
+	return s.sodHandler.ListRules(ctx)
+}
+
+func (s *server) RequestAccess(ctx context.Context, req *pb.RequestAccessRequest) (*pb.AccessRequest, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.accessHandler.RequestAccess(ctx, subjectID, req.GroupId, req.Reason, req.DurationSeconds)
+}
+
+func (s *server) GetAccessRequest(ctx context.Context, req *pb.AccessRequestByIDRequest) (*pb.AccessRequest, error) {
+	return s.accessHandler.GetAccessRequest(ctx, req.RequestId)
+}
+
+func (s *server) CancelAccessRequest(ctx context.Context, req *pb.AccessRequestByIDRequest) (*pb.AccessRequest, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.accessHandler.CancelAccessRequest(ctx, req.RequestId, subjectID)
+}
+
+func (s *server) ApproveAccessRequest(ctx context.Context, req *pb.AccessDecisionRequest) (*pb.AccessRequest, error) {
+	approverID, err := callerSubjectID(ctx, req.ApproverId)
+	if err != nil {
+		return nil, err
+	}
+	return s.accessHandler.Approve(ctx, req.RequestId, approverID, req.Reason)
+}
+
+func (s *server) DenyAccessRequest(ctx context.Context, req *pb.AccessDecisionRequest) (*pb.AccessRequest, error) {
+	approverID, err := callerSubjectID(ctx, req.ApproverId)
+	if err != nil {
+		return nil, err
+	}
+	return s.accessHandler.Deny(ctx, req.RequestId, approverID, req.Reason)
+}
+
+func (s *server) ListPendingAccessRequests(ctx context.Context, req *pb.ListPendingAccessRequestsRequest) (*pb.AccessRequestsResponse, error) {
+	approverID, err := callerSubjectID(ctx, req.ApproverId)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.accessHandler.ListPendingForApprover(ctx, approverID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AccessRequestsResponse{Requests: requests}, nil
+}
+
+// callerSubjectID is the subject an rpc acts as: the authenticated principal, or the claimed subject when
+// authentication is disabled or the caller is a bootstrap admin acting on behalf of others
+func callerSubjectID(ctx context.Context, claimed string) (string, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil || principal.Bootstrap && claimed != "" {
+		return claimed, nil
+	}
+	if claimed != "" && claimed != principal.SubjectID {
+		return "", status.Error(codes.PermissionDenied, "can not act as subject "+claimed)
+	}
+	return principal.SubjectID, nil
+}
+
+// the totp rpcs act as the authenticated caller, so subjects can only manage and verify their own enrollment
+
+func (s *server) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaHandler.EnrollTOTP(ctx, subjectID, req.AccountName, req.MfaSessionId)
+}
+
+func (s *server) ConfirmTOTP(ctx context.Context, req *pb.VerifyTOTPRequest) (*pb.EmptyResponse, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaHandler.ConfirmTOTP(ctx, subjectID, req.Code)
+}
+
+func (s *server) VerifyTOTP(ctx context.Context, req *pb.VerifyTOTPRequest) (*pb.MFASessionResponse, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaHandler.VerifyTOTP(ctx, subjectID, req.Code)
+}
+
+func (s *server) RedeemRecoveryCode(ctx context.Context, req *pb.RecoveryCodeRequest) (*pb.MFASessionResponse, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaHandler.RedeemRecoveryCode(ctx, subjectID, req.RecoveryCode)
+}
+
+func (s *server) DeleteTOTP(ctx context.Context, req *pb.DeleteTOTPRequest) (*pb.EmptyResponse, error) {
+	subjectID, err := callerSubjectID(ctx, req.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaHandler.DeleteTOTP(ctx, subjectID, req.MfaSessionId)
+}
+
+func (s *server) FederatedLogin(ctx context.Context, req *pb.FederatedLoginRequest) (*pb.FederatedLoginResponse, error) {
+	return s.federationHandler.Login(ctx, req.IdToken)
+}
+
+func (s *server) LinkFederatedIdentity(ctx context.Context, req *pb.LinkFederatedIdentityRequest) (*pb.FederatedIdentity, error) {
+	return s.federationHandler.LinkIdentity(ctx, req.SubjectId, req.IdToken)
+}
+
+func (s *server) UnlinkFederatedIdentity(ctx context.Context, req *pb.FederatedIdentity) (*pb.EmptyResponse, error) {
+	return s.federationHandler.UnlinkIdentity(ctx, req.Issuer, req.ExternalSubject)
+}
+
+func (s *server) ListFederatedIdentities(ctx context.Context, req *pb.SubjectIDRequest) (*pb.FederatedIdentitiesResponse, error) {
+	return s.federationHandler.ListIdentities(ctx, req.SubjectId)
+}
+
+// StartServer serves the grpc api, interceptors run after the tracing/logging interceptor in the given order
+func StartServer(serverCfg config.ServerConfig, server pb.AuthNZServer, interceptors ...grpc.UnaryServerInterceptor) error {
 	lis, err := net.Listen("tcp", serverCfg.GRPC)
 	if err != nil {
 		return err
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	interceptors = append([]grpc.UnaryServerInterceptor{func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		tracingID, _ := uuid.NewV4()
 		ctx = logging.WrapCtx(ctx, "traceID", tracingID.String())
-		logging.GlobalLogger.Infof(ctx, "[%s] received grpc request %v ", info.FullMethod, req)
+		// requests and responses carry secrets such as totp codes, recovery codes and id tokens, so only the
+		// outcome is logged
+		logging.GlobalLogger.Infof(ctx, "[%s] received grpc request", info.FullMethod)
 		resp, err = handler(ctx, req)
-		logging.GlobalLogger.Infof(ctx, "[%s] request done with response: {%v} err: %v", info.FullMethod, resp, err)
+		logging.GlobalLogger.Infof(ctx, "[%s] request done with code %s", info.FullMethod, status.Code(err))
 		return
-	}))
+	}}, interceptors...)
+	serverOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+	if serverCfg.TLS.CertFile != "" {
+		tlsConfig, err := tlsutil.NewServerConfig(serverCfg.TLS.CertFile, serverCfg.TLS.KeyFile, serverCfg.TLS.ClientCAFile, serverCfg.TLS.RequireClientCert)
+		if err != nil {
+			return err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s := grpc.NewServer(serverOpts...)
 	pb.RegisterAuthNZServer(s, server)
 	reflection.Register(s)
-	logging.GlobalLogger.Infof(context.Background(), "server started on %s", serverCfg.GRPC)
+	logging.GlobalLogger.Infof(context.Background(), "server started on %s (tls: %v)", serverCfg.GRPC, serverCfg.TLS.CertFile != "")
 	return s.Serve(lis)
 }
+
+// StartHTTPServer serves handler over https when tlsCfg has a certificate, timeouts keep slow clients from
+// holding connections
+func StartHTTPServer(addr string, tlsCfg config.TLSConfig, handler http.Handler) error {
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       time.Minute,
+	}
+	if tlsCfg.CertFile == "" {
+		logging.GlobalLogger.Infof(context.Background(), "http server started on %s (tls: false)", addr)
+		return httpServer.ListenAndServe()
+	}
+	tlsConfig, err := tlsutil.NewServerConfig(tlsCfg.CertFile, tlsCfg.KeyFile, "", false)
+	if err != nil {
+		return err
+	}
+	httpServer.TLSConfig = tlsConfig
+	logging.GlobalLogger.Infof(context.Background(), "http server started on %s (tls: true)", addr)
+	// the certificate comes from the reloading tls config
+	return httpServer.ListenAndServeTLS("", "")
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/dlshle/authnz/internal/auth"
+	"github.com/dlshle/authnz/pkg/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// sessionHeader lets unauthenticated callers tie their reads to their own writes
+const sessionHeader = "x-authnz-session"
+
+// NewSessionInterceptor gives every caller read-your-writes consistency when reads are served by replicas:
+// after a successful write rpc the caller's reads go to the primary for the configured window.
+// It has to run after the auth interceptor so the principal identifies the session.
+func NewSessionInterceptor(replicas *store.ReplicaSet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = store.WithSession(ctx, sessionOf(ctx))
+		resp, err := handler(ctx, req)
+		if err == nil && !isReadMethod(path.Base(info.FullMethod)) {
+			replicas.MarkWrite(ctx)
+		}
+		return resp, err
+	}
+}
+
+// sessionOf falls back to a session shared by all anonymous callers, which errs on the side of the primary
+func sessionOf(ctx context.Context) string {
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		return "subject:" + principal.SubjectID
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(sessionHeader); len(values) > 0 {
+			return "header:" + values[0]
+		}
+	}
+	return ""
+}
+
+func isReadMethod(method string) bool {
+	return method == "authorize" || method == "checkPermission" || method == "checkRelation" || method == "expandRelation" || strings.HasPrefix(method, "get") || strings.HasPrefix(method, "list") || strings.HasPrefix(method, "find")
+}
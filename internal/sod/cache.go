@@ -0,0 +1,56 @@
+package sod
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/dlshle/authnz/proto"
+)
+
+// rules written through another server are enforced by this one after at most this long
+const rulesCacheTTL = 10 * time.Second
+
+type cachedStore struct {
+	Store
+	rules    []*pb.SoDRule
+	loadedAt time.Time
+	mutex    sync.Mutex
+}
+
+// NewCachedStore keeps the rules of store in memory since every contract add and authorization check reads them,
+// writes through the cached store drop them at once. The listed rules are shared and must not be modified.
+func NewCachedStore(store Store) Store {
+	return &cachedStore{Store: store}
+}
+
+func (s *cachedStore) List(ctx context.Context) ([]*pb.SoDRule, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.rules != nil && time.Since(s.loadedAt) < rulesCacheTTL {
+		return s.rules, nil
+	}
+	rules, err := s.Store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.rules, s.loadedAt = rules, time.Now()
+	return rules, nil
+}
+
+func (s *cachedStore) Put(rule *pb.SoDRule) (*pb.SoDRule, error) {
+	defer s.invalidate()
+	return s.Store.Put(rule)
+}
+
+func (s *cachedStore) Delete(id string) error {
+	defer s.invalidate()
+	return s.Store.Delete(id)
+}
+
+// invalidate runs after a write so a List that loaded the rules before the write finished can not keep them
+func (s *cachedStore) invalidate() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rules = nil
+}
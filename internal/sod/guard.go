@@ -0,0 +1,176 @@
+package sod
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ViolationError is returned when a subject would be, or is, in more than one group of a rule
+type ViolationError struct {
+	RuleID    string
+	SubjectID string
+	GroupIDs  []string
+	Msg       string
+}
+
+func (e *ViolationError) Error() string {
+	return e.Msg
+}
+
+func (e *ViolationError) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Msg)
+}
+
+// Guard enforces the rules, static ones when contracts are added and all of them on the groups a subject
+// is authorized with
+type Guard struct {
+	store         Store
+	groupStore    group.Store
+	contractStore contract.Store
+}
+
+func NewGuard(store Store, groupStore group.Store, contractStore contract.Store) *Guard {
+	return &Guard{store: store, groupStore: groupStore, contractStore: contractStore}
+}
+
+// Prepare implements contract.Guard, the check sees every contract of the subject regardless of its window
+func (g *Guard) Prepare(ctx context.Context) (contract.GuardCheck, error) {
+	rules, err := g.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var static []*pb.SoDRule
+	for _, rule := range rules {
+		if rule.Kind == pb.SoDRule_STATIC {
+			static = append(static, rule)
+		}
+	}
+	return func(tx store.SQLTransactional, subjectID string, groupIDs []string) error {
+		if len(static) == 0 {
+			return nil
+		}
+		// a concurrent transaction adding a conflicting contract of the subject would not see this one
+		if err := g.contractStore.TxLockSubjectContracts(tx, subjectID); err != nil {
+			return err
+		}
+		held, err := g.contractStore.TxListAllContractsBySubject(tx, subjectID)
+		if err != nil {
+			return err
+		}
+		added := make(map[string]bool)
+		ids := make([]string, 0, len(held)+len(groupIDs))
+		for _, groupID := range groupIDs {
+			added[groupID] = true
+			ids = append(ids, groupID)
+		}
+		for _, c := range held {
+			if !added[c.GroupID] {
+				ids = append(ids, c.GroupID)
+			}
+		}
+		groups, err := g.groupStore.TxBulkGet(tx, ids)
+		if err != nil {
+			return err
+		}
+		lineages, err := group.TxLineages(tx, g.groupStore, groups)
+		if err != nil {
+			return err
+		}
+		for _, rule := range static {
+			// conflicts the subject already had, e.g. from before the rule, do not block unrelated contracts
+			if err := violation(rule, subjectID, lineages, added); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// CheckGroups checks every rule against the groups of the subject's contracts in effect and their ancestors
+func (g *Guard) CheckGroups(ctx context.Context, subjectID string, groups []*pb.Group) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	rules, err := g.store.List(ctx)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+	var lineages []*group.Lineage
+	err = g.groupStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		lineages, err = group.TxLineages(tx, g.groupStore, groups)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := violation(rule, subjectID, lineages, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// violation returns an error when the lineages match more than one selector of rule and, unless involving is nil,
+// one of the matching groups is in involving
+func violation(rule *pb.SoDRule, subjectID string, lineages []*group.Lineage, involving map[string]bool) error {
+	matchedSelectors := 0
+	involved := involving == nil
+	var groupIDs []string
+	seen := make(map[string]bool)
+	for _, selector := range rule.Groups {
+		matched := false
+		for _, lineage := range lineages {
+			if !Matches(selector, lineage) {
+				continue
+			}
+			matched = true
+			involved = involved || involving[lineage.Group.Id]
+			if !seen[lineage.Group.Id] {
+				seen[lineage.Group.Id] = true
+				groupIDs = append(groupIDs, lineage.Group.Id)
+			}
+		}
+		if matched {
+			matchedSelectors++
+		}
+	}
+	if matchedSelectors < 2 || !involved {
+		return nil
+	}
+	return &ViolationError{
+		RuleID:    rule.Id,
+		SubjectID: subjectID,
+		GroupIDs:  groupIDs,
+		Msg:       "subject " + subjectID + " can not be in groups " + strings.Join(groupIDs, ", ") + " at once due to separation of duties rule " + rule.Name + " (" + rule.Id + ")",
+	}
+}
+
+// Matches tells if the group or one of its ancestors is the selected one, or the group carries the selected
+// attribute itself or by inheritance
+func Matches(selector *pb.SoDGroup, lineage *group.Lineage) bool {
+	if selector.GroupId != "" {
+		if selector.GroupId == lineage.Group.Id {
+			return true
+		}
+		for _, ancestorID := range lineage.AncestorIDs {
+			if selector.GroupId == ancestorID {
+				return true
+			}
+		}
+		return false
+	}
+	for _, attribute := range lineage.Attributes {
+		if attribute.Key == selector.GetAttribute().GetKey() && attribute.Value == selector.GetAttribute().GetValue() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package sod
+
+import (
+	"context"
+
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+)
+
+type Handler struct {
+	store Store
+}
+
+func NewHandler(store Store) *Handler {
+	return &Handler{store: store}
+}
+
+// CreateRule stores a rule, contracts that already break it are only reported by authorization checks
+func (h *Handler) CreateRule(ctx context.Context, rule *pb.SoDRule) (*pb.SoDRule, error) {
+	if err := validate(rule); err != nil {
+		return nil, err
+	}
+	return h.store.Put(rule)
+}
+
+func (h *Handler) UpdateRule(ctx context.Context, rule *pb.SoDRule) (*pb.SoDRule, error) {
+	if err := validate(rule); err != nil {
+		return nil, err
+	}
+	return h.store.Put(rule)
+}
+
+func (h *Handler) DeleteRule(ctx context.Context, ruleID string) (*pb.EmptyResponse, error) {
+	err := h.store.Delete(ruleID)
+	return &pb.EmptyResponse{}, err
+}
+
+func (h *Handler) GetRuleByID(ctx context.Context, ruleID string) (*pb.SoDRule, error) {
+	return h.store.Get(ctx, ruleID)
+}
+
+func (h *Handler) ListRules(ctx context.Context) (*pb.SoDRulesResponse, error) {
+	rules, err := h.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SoDRulesResponse{Rules: rules}, nil
+}
+
+func validate(rule *pb.SoDRule) error {
+	if rule.Name == "" {
+		return errors.Error("rule name is required")
+	}
+	if len(rule.Groups) < 2 {
+		return errors.Error("a separation of duties rule needs at least two groups")
+	}
+	for _, selector := range rule.Groups {
+		if (selector.GroupId == "") == (selector.Attribute == nil) {
+			return errors.Error("a rule group is selected by either a group id or an attribute")
+		}
+		if selector.Attribute != nil && selector.Attribute.Key == "" {
+			return errors.Error("attribute key of a rule group is required")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package sod
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/utils"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type Store interface {
+	Get(ctx context.Context, id string) (*pb.SoDRule, error)
+	// List returns every rule, there are expected to be few of them
+	List(ctx context.Context) ([]*pb.SoDRule, error)
+	Delete(id string) error
+	Put(rule *pb.SoDRule) (*pb.SoDRule, error)
+}
+
+// PBSoDRuleStore is backed by the entity store its constructor opens
+type PBSoDRuleStore struct {
+	pbEntityStore store.PBEntityStore
+	format        store.PayloadFormat
+}
+
+func NewSQLStore(db *sqlx.DB, format store.PayloadFormat) Store {
+	return &PBSoDRuleStore{pbEntityStore: store.NewSQLPBEntityStore(db, "sod_rules"), format: format}
+}
+
+func NewReplicatedSQLStore(replicas *store.ReplicaSet, format store.PayloadFormat) Store {
+	return &PBSoDRuleStore{pbEntityStore: store.NewReplicatedSQLPBEntityStore(replicas, "sod_rules"), format: format}
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &PBSoDRuleStore{pbEntityStore: store.NewKVPBEntityStore(db, "sod_rules")}
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &PBSoDRuleStore{pbEntityStore: store.NewMemoryPBEntityStore(db, "sod_rules")}
+}
+
+func (s *PBSoDRuleStore) Get(ctx context.Context, id string) (*pb.SoDRule, error) {
+	var pbEntity *store.PBEntity
+	err := s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		pbEntity, err = s.pbEntityStore.TxGet(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rule := &pb.SoDRule{}
+	err = store.DecodePBEntity(pbEntity, rule)
+	return rule, err
+}
+
+func (s *PBSoDRuleStore) List(ctx context.Context) ([]*pb.SoDRule, error) {
+	var entities []*store.PBEntity
+	err := s.pbEntityStore.View(ctx, func(tx store.SQLTransactional) (err error) {
+		entities, err = s.pbEntityStore.TxFindByDocument(tx, []byte("{}"))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]*pb.SoDRule, len(entities), len(entities))
+	for i, entity := range entities {
+		rule := &pb.SoDRule{}
+		if err = store.DecodePBEntity(entity, rule); err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+func (s *PBSoDRuleStore) Put(rule *pb.SoDRule) (ret *pb.SoDRule, err error) {
+	var (
+		pbEntity *store.PBEntity
+	)
+	err = utils.ProcessWithErrors(func() error {
+		if rule.Id == "" {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rule.Id = newID.String()
+		}
+		return nil
+	}, func() error {
+		pbEntity, err = store.EncodePBEntity(rule.Id, rule, s.format)
+		return err
+	}, func() error {
+		pbEntity, err = s.pbEntityStore.Put(pbEntity)
+		return err
+	}, func() error {
+		rule.Id = pbEntity.ID
+		rule.Version = pbEntity.Version
+		return nil
+	})
+	return rule, err
+}
+
+func (s *PBSoDRuleStore) Delete(id string) error {
+	return s.pbEntityStore.Delete(id)
+}
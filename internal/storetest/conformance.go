@@ -0,0 +1,622 @@
+// Package storetest verifies that a storage backend behaves like the postgres one, in the spirit of testing/fstest.
+// Every backend is expected to pass Check against an empty database.
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type Stores struct {
+	Subject  subject.Store
+	Group    group.Store
+	Policy   policy.Store
+	Contract contract.Store
+	// a PBEntityStore on its own table
+	Entities store.PBEntityStore
+}
+
+// Check runs every conformance case against the stores and returns the first failure
+func Check(stores Stores) error {
+	cases := []struct {
+		name string
+		run  func(Stores) error
+	}{
+		{"entity round trip", checkEntityRoundTrip},
+		{"entity bulk put and delete", checkEntityBulkPutAndDelete},
+		{"entity versions", checkEntityVersions},
+		{"find by document", checkFindByDocument},
+		{"hostile ids", checkHostileIDs},
+		{"subject round trip", checkSubjectRoundTrip},
+		{"group and policy round trip", checkGroupAndPolicyRoundTrip},
+		{"policy targets", checkPolicyTargets},
+		{"contract constraints", checkContractConstraints},
+		{"contract windows", checkContractWindows},
+		{"break-glass contracts", checkBreakGlassContracts},
+		{"cascading deletes", checkCascadingDeletes},
+		{"transaction rollback", checkRollback},
+	}
+	for _, c := range cases {
+		if err := c.run(stores); err != nil {
+			return errors.Error(c.name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+func checkEntityRoundTrip(stores Stores) error {
+	entity, err := stores.Entities.Put(&store.PBEntity{Payload: []byte("payload")})
+	if err != nil {
+		return err
+	}
+	if entity.ID == "" {
+		return errors.Error("put did not assign an id")
+	}
+	got, err := stores.Entities.Get(entity.ID)
+	if err != nil {
+		return err
+	}
+	if string(got.Payload) != "payload" {
+		return fmt.Errorf("got payload %q, want %q", got.Payload, "payload")
+	}
+	err = stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		entities, err := stores.Entities.TxBulkGet(tx, []string{entity.ID, "00000000-0000-0000-0000-000000000000"})
+		if err != nil {
+			return err
+		}
+		if len(entities) != 1 {
+			return fmt.Errorf("bulk get returned %d entities, want 1", len(entities))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = stores.Entities.Delete(entity.ID); err != nil {
+		return err
+	}
+	if _, err = stores.Entities.Get(entity.ID); err == nil {
+		return errors.Error("entity is still readable after delete")
+	}
+	if err = stores.Entities.Delete(entity.ID); err == nil {
+		return errors.Error("deleting a missing entity did not fail")
+	}
+	return nil
+}
+
+func checkEntityBulkPutAndDelete(stores Stores) error {
+	var entities []*store.PBEntity
+	err := stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) (err error) {
+		entities, err = stores.Entities.TxBulkPut(tx, []*store.PBEntity{{Payload: []byte("a")}, {Payload: []byte("b")}})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	ids := []string{entities[0].ID, entities[1].ID}
+	err = stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		return stores.Entities.TxBulkDelete(tx, append(ids, "00000000-0000-0000-0000-000000000000"))
+	})
+	if err == nil {
+		return errors.Error("bulk deleting a missing entity did not fail")
+	}
+	for _, id := range ids {
+		if _, err = stores.Entities.Get(id); err != nil {
+			return errors.Error("failed bulk delete removed " + id)
+		}
+	}
+	err = stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		return stores.Entities.TxBulkDelete(tx, ids)
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err = stores.Entities.Get(id); err == nil {
+			return errors.Error(id + " is still readable after bulk delete")
+		}
+	}
+	return nil
+}
+
+func checkEntityVersions(stores Stores) error {
+	entity, err := stores.Entities.Put(&store.PBEntity{Payload: []byte("first")})
+	if err != nil {
+		return err
+	}
+	if entity.Version != 1 {
+		return fmt.Errorf("put created version %d, want 1", entity.Version)
+	}
+	for _, version := range []int64{0, 2} {
+		_, err = stores.Entities.Put(&store.PBEntity{ID: entity.ID, Payload: []byte("overwritten"), Version: version})
+		if status.Code(err) != codes.Aborted {
+			return fmt.Errorf("put of version %d over version 1 returned %v, want a conflict", version, err)
+		}
+	}
+	if entity, err = stores.Entities.Put(&store.PBEntity{ID: entity.ID, Payload: []byte("second"), Version: 1}); err != nil {
+		return err
+	}
+	got, err := stores.Entities.Get(entity.ID)
+	if err != nil {
+		return err
+	}
+	if string(got.Payload) != "second" || got.Version != 2 {
+		return fmt.Errorf("got payload %q at version %d, want %q at version 2", got.Payload, got.Version, "second")
+	}
+	return stores.Entities.Delete(entity.ID)
+}
+
+func checkFindByDocument(stores Stores) error {
+	documents := []string{
+		`{"state":"PENDING","subjectId":"a","labels":["x","y"],"target":{"kind":"table"}}`,
+		`{"state":"PENDING","subjectId":"b","labels":["x"]}`,
+		`{"state":"GRANTED","subjectId":"a","labels":["y"],"target":{"kind":"column"}}`,
+	}
+	ids := make([]string, len(documents))
+	for i, document := range documents {
+		entity, err := stores.Entities.Put(&store.PBEntity{Payload: []byte("payload"), Document: []byte(document)})
+		if err != nil {
+			return err
+		}
+		ids[i] = entity.ID
+	}
+	for containment, want := range map[string][]string{
+		`{}`:                                     ids,
+		`{"state":"PENDING"}`:                    ids[:2],
+		`{"state":"PENDING","subjectId":"a"}`:    ids[:1],
+		`{"labels":["y"]}`:                       {ids[0], ids[2]},
+		`{"target":{}}`:                          {ids[0], ids[2]},
+		`{"target":{"kind":"column"}}`:           ids[2:],
+		`{"state":"REVOKED"}`:                    nil,
+		`{"state":"PENDING","labels":["x","z"]}`: nil,
+	} {
+		entities, err := stores.Entities.FindByDocument([]byte(containment))
+		if err != nil {
+			return err
+		}
+		found := map[string]bool{}
+		for _, entity := range entities {
+			found[entity.ID] = true
+		}
+		if len(found) != len(want) {
+			return fmt.Errorf("%s matched %d entities, want %d", containment, len(found), len(want))
+		}
+		for _, id := range want {
+			if !found[id] {
+				return fmt.Errorf("%s did not match %s", containment, id)
+			}
+		}
+	}
+	return stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		return stores.Entities.TxBulkDelete(tx, ids)
+	})
+}
+
+// hostileIDs try to break out of a quoted IN list, a bulk operation either rejects them or matches nothing
+var hostileIDs = []string{
+	"') OR ('1'='1",
+	"' OR id IS NOT NULL --",
+	"'); DELETE FROM subjects; --",
+	`\'`,
+	"$1",
+	"?",
+}
+
+func checkHostileIDs(stores Stores) error {
+	entity, err := stores.Entities.Put(&store.PBEntity{Payload: []byte("payload")})
+	if err != nil {
+		return err
+	}
+	subject, err := stores.Subject.Put(&pb.Subject{UserId: "conformance-hostile"})
+	if err != nil {
+		return err
+	}
+	for _, id := range hostileIDs {
+		// postgres rejects them as malformed uuids, every other backend looks them up verbatim
+		var entities []*store.PBEntity
+		stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) (err error) {
+			entities, err = stores.Entities.TxBulkGet(tx, []string{id})
+			return err
+		})
+		if len(entities) > 0 {
+			return fmt.Errorf("bulk get of %q returned %d entities", id, len(entities))
+		}
+		stores.Entities.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+			return stores.Entities.TxBulkDelete(tx, []string{id})
+		})
+		var subjects []*pb.Subject
+		stores.Subject.WithTX(context.Background(), func(tx store.SQLTransactional) (err error) {
+			subjects, err = stores.Subject.TxBulkGet(tx, []string{id})
+			return err
+		})
+		if len(subjects) > 0 {
+			return fmt.Errorf("subject bulk get of %q returned %d subjects", id, len(subjects))
+		}
+	}
+	if _, err = stores.Entities.Get(entity.ID); err != nil {
+		return errors.Error("hostile ids removed an entity: " + err.Error())
+	}
+	if _, err = stores.Subject.Get(subject.Id); err != nil {
+		return errors.Error("hostile ids removed a subject: " + err.Error())
+	}
+	if err = stores.Entities.Delete(entity.ID); err != nil {
+		return err
+	}
+	return stores.Subject.Delete(subject.Id)
+}
+
+func checkSubjectRoundTrip(stores Stores) error {
+	created, err := stores.Subject.Put(&pb.Subject{UserId: "conformance-user"})
+	if err != nil {
+		return err
+	}
+	got, err := stores.Subject.Get(created.Id)
+	if err != nil {
+		return err
+	}
+	if got.UserId != "conformance-user" {
+		return fmt.Errorf("got user id %q, want %q", got.UserId, "conformance-user")
+	}
+	found, err := stores.Subject.FindByUserID(context.Background(), "conformance-user")
+	if err != nil {
+		return err
+	}
+	if len(found) != 1 || found[0].Id != created.Id {
+		return fmt.Errorf("find by user id returned %v", found)
+	}
+	err = stores.Subject.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		subjects, err := stores.Subject.TxBulkGet(tx, []string{created.Id})
+		if err != nil {
+			return err
+		}
+		if len(subjects) != 1 || subjects[0].UserId != "conformance-user" {
+			return fmt.Errorf("bulk get returned %v", subjects)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = stores.Subject.Delete(created.Id); err != nil {
+		return err
+	}
+	if _, err = stores.Subject.Get(created.Id); err == nil {
+		return errors.Error("subject is still readable after delete")
+	}
+	return nil
+}
+
+func checkGroupAndPolicyRoundTrip(stores Stores) error {
+	attributes := []*pb.Attribute{{Key: "role", Value: "admin"}}
+	group, err := stores.Group.Put(&pb.Group{Attributes: attributes})
+	if err != nil {
+		return err
+	}
+	got, err := stores.Group.Get(group.Id)
+	if err != nil {
+		return err
+	}
+	if !proto.Equal(got, group) {
+		return fmt.Errorf("got group %v, want %v", got, group)
+	}
+	if err = stores.Group.Delete(group.Id); err != nil {
+		return err
+	}
+	policy, err := stores.Policy.Put(&pb.Policy{})
+	if err != nil {
+		return err
+	}
+	if _, err = stores.Policy.Get(context.Background(), policy.Id); err != nil {
+		return err
+	}
+	return stores.Policy.Delete(policy.Id)
+}
+
+func checkPolicyTargets(stores Stores) error {
+	ctx := context.Background()
+	readDocs, err := stores.Policy.Put(&pb.Policy{Target: &pb.PolicyTarget{Actions: []string{"read"}, ResourceTypes: []string{"doc"}}})
+	if err != nil {
+		return err
+	}
+	anyOnDocs, err := stores.Policy.Put(&pb.Policy{Target: &pb.PolicyTarget{ResourceTypes: []string{"doc"}}})
+	if err != nil {
+		return err
+	}
+	writes, err := stores.Policy.Put(&pb.Policy{Target: &pb.PolicyTarget{Actions: []string{"write"}}})
+	if err != nil {
+		return err
+	}
+	untargeted, err := stores.Policy.Put(&pb.Policy{})
+	if err != nil {
+		return err
+	}
+	if err = expectPolicies(stores, "read", "doc", readDocs.Id, anyOnDocs.Id); err != nil {
+		return err
+	}
+	// updates replace the target
+	readDocs.Target.Actions = []string{"write"}
+	if readDocs, err = stores.Policy.Put(readDocs); err != nil {
+		return err
+	}
+	if err = expectPolicies(stores, "read", "doc", anyOnDocs.Id); err != nil {
+		return err
+	}
+	if err = expectPolicies(stores, "write", "doc", readDocs.Id, anyOnDocs.Id, writes.Id); err != nil {
+		return err
+	}
+	for _, policy := range []*pb.Policy{readDocs, anyOnDocs, writes, untargeted} {
+		if err = stores.Policy.Delete(policy.Id); err != nil {
+			return err
+		}
+	}
+	policies, err := stores.Policy.ListByTarget(ctx, "write", "doc")
+	if err != nil {
+		return err
+	}
+	if len(policies) != 0 {
+		return fmt.Errorf("deleted policies are still listed: %v", policies)
+	}
+	return nil
+}
+
+func expectPolicies(stores Stores, action, resourceType string, ids ...string) error {
+	policies, err := stores.Policy.ListByTarget(context.Background(), action, resourceType)
+	if err != nil {
+		return err
+	}
+	expected := make(map[string]bool)
+	for _, id := range ids {
+		expected[id] = true
+	}
+	for _, policy := range policies {
+		if !expected[policy.Id] {
+			return fmt.Errorf("policy %s is listed for %s on %s", policy.Id, action, resourceType)
+		}
+		delete(expected, policy.Id)
+	}
+	if len(expected) > 0 {
+		return fmt.Errorf("policies %v are not listed for %s on %s", expected, action, resourceType)
+	}
+	return nil
+}
+
+func checkContractConstraints(stores Stores) error {
+	subject, group, err := putSubjectAndGroup(stores)
+	if err != nil {
+		return err
+	}
+	if _, err = stores.Contract.AddNewContract(subject.Id, group.Id, contract.Window{}); err != nil {
+		return err
+	}
+	if _, err = stores.Contract.AddNewContract(subject.Id, group.Id, contract.Window{}); err == nil {
+		return errors.Error("duplicate contract was accepted")
+	} else if _, ok := err.(*store.DuplicateError); !ok {
+		return fmt.Errorf("duplicate contract failed with %T, want *store.DuplicateError", err)
+	}
+	if _, err = stores.Contract.AddNewContract("00000000-0000-0000-0000-000000000000", group.Id, contract.Window{}); err == nil {
+		return errors.Error("contract for a missing subject was accepted")
+	} else if _, ok := err.(*store.ReferenceError); !ok {
+		return fmt.Errorf("dangling contract failed with %T, want *store.ReferenceError", err)
+	}
+	memberships, err := stores.Contract.ListMembershipsBySubjectID(context.Background(), subject.Id)
+	if err != nil {
+		return err
+	}
+	if len(memberships) != 1 || memberships[0].Group.Id != group.Id || memberships[0].BreakGlassContractID != "" {
+		return fmt.Errorf("list memberships by subject returned %v", memberships)
+	}
+	if err = stores.Contract.DeleteContract(subject.Id, group.Id); err != nil {
+		return err
+	}
+	if err = stores.Contract.DeleteContract(subject.Id, group.Id); err == nil {
+		return errors.Error("deleting a missing contract did not fail")
+	}
+	if err = stores.Subject.Delete(subject.Id); err != nil {
+		return err
+	}
+	return stores.Group.Delete(group.Id)
+}
+
+func checkContractWindows(stores Stores) error {
+	subject, group, err := putSubjectAndGroup(stores)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	past, future := now.Add(-time.Hour).Truncate(time.Second), now.Add(time.Hour).Truncate(time.Second)
+	added, err := stores.Contract.AddNewContract(subject.Id, group.Id, contract.Window{ExpiresAt: &past})
+	if err != nil {
+		return err
+	}
+	if added.ExpiresAt != past.Unix() {
+		return fmt.Errorf("added contract expires at %d, want %d", added.ExpiresAt, past.Unix())
+	}
+	windows := []struct {
+		window contract.Window
+		active bool
+	}{
+		{contract.Window{ExpiresAt: &past}, false},
+		{contract.Window{NotBefore: &future}, false},
+		{contract.Window{NotBefore: &past, ExpiresAt: &future}, true},
+		{contract.Window{}, true},
+	}
+	for i, w := range windows {
+		if _, err = stores.Contract.UpdateContractWindow(context.Background(), added.Id, w.window); err != nil {
+			return err
+		}
+		memberships, err := stores.Contract.ListMembershipsBySubjectID(context.Background(), subject.Id)
+		if err != nil {
+			return err
+		}
+		if (len(memberships) == 1) != w.active {
+			return fmt.Errorf("list memberships by subject returned %d groups for window %d", len(memberships), i)
+		}
+	}
+	if _, err = stores.Contract.UpdateContractWindow(context.Background(), "00000000-0000-0000-0000-000000000000", contract.Window{}); err == nil {
+		return errors.Error("updating the window of a missing contract did not fail")
+	}
+	if _, err = stores.Contract.UpdateContractWindow(context.Background(), added.Id, contract.Window{ExpiresAt: &future}); err != nil {
+		return err
+	}
+	if expired, err := stores.Contract.DeleteExpiredContracts(context.Background(), now); err != nil || len(expired) != 0 {
+		return fmt.Errorf("reaped %d contracts that did not expire yet, error %v", len(expired), err)
+	}
+	expired, err := stores.Contract.DeleteExpiredContracts(context.Background(), future)
+	if err != nil {
+		return err
+	}
+	if len(expired) != 1 || expired[0].ID != added.Id || expired[0].ExpiresAt == nil || !expired[0].ExpiresAt.Equal(future) {
+		return fmt.Errorf("reaped %v, want contract %s", expired, added.Id)
+	}
+	contracts, err := contractsByGroupID(stores, group.Id)
+	if err != nil {
+		return err
+	}
+	if len(contracts) != 0 {
+		return fmt.Errorf("%d contracts survived the reaper", len(contracts))
+	}
+	if err = stores.Subject.Delete(subject.Id); err != nil {
+		return err
+	}
+	return stores.Group.Delete(group.Id)
+}
+
+func checkBreakGlassContracts(stores Stores) error {
+	subject, group, err := putSubjectAndGroup(stores)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	justification, grantedBy := "incident 42", "on-call"
+	var added *pb.Contract
+	err = stores.Contract.WithTx(context.Background(), func(tx store.SQLTransactional) (err error) {
+		added, err = stores.Contract.TxAddBreakGlassContract(tx, subject.Id, group.Id, contract.Window{ExpiresAt: &expiresAt},
+			contract.BreakGlass{Justification: &justification, GrantedBy: &grantedBy})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if added.BreakGlass.GetJustification() != justification || added.BreakGlass.GetGrantedBy() != grantedBy {
+		return fmt.Errorf("added contract has break glass %v", added.BreakGlass)
+	}
+	contracts, err := stores.Contract.ListAllContractsBySubject(subject.Id)
+	if err != nil {
+		return err
+	}
+	if len(contracts) != 1 || !contracts[0].IsBreakGlass() || *contracts[0].Justification != justification || *contracts[0].GrantedBy != grantedBy {
+		return fmt.Errorf("listed %v, want break-glass contract %s", contracts, added.Id)
+	}
+	memberships, err := stores.Contract.ListMembershipsBySubjectID(context.Background(), subject.Id)
+	if err != nil {
+		return err
+	}
+	if len(memberships) != 1 || memberships[0].BreakGlassContractID != added.Id {
+		return fmt.Errorf("list memberships by subject returned %v, want break-glass contract %s", memberships, added.Id)
+	}
+	decidedAt := time.Now().Truncate(time.Second).UTC()
+	decisions := []contract.BreakGlassDecision{
+		{ContractID: added.Id, SubjectID: subject.Id, Check: "permission write", Verdict: pb.Verdict_DENIED.String(), DecidedAt: decidedAt.Add(time.Second)},
+		{ContractID: added.Id, SubjectID: subject.Id, Check: "permission read", Verdict: pb.Verdict_PERMITTED.String(), DecidedAt: decidedAt},
+	}
+	if err = stores.Contract.AddBreakGlassDecisions(context.Background(), decisions); err != nil {
+		return err
+	}
+	extended := expiresAt.Add(24 * time.Hour)
+	if _, err = stores.Contract.UpdateContractWindow(context.Background(), added.Id, contract.Window{ExpiresAt: &extended}); status.Code(err) != codes.FailedPrecondition {
+		return fmt.Errorf("extending a break-glass contract got %v, want FailedPrecondition", err)
+	}
+	err = stores.Contract.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		_, err := stores.Contract.TxAddBreakGlassContract(tx, subject.Id, group.Id, contract.Window{}, contract.BreakGlass{Justification: &justification})
+		return err
+	})
+	if err == nil {
+		return errors.Error("adding a duplicate break-glass contract did not fail")
+	}
+	if err = stores.Subject.Delete(subject.Id); err != nil {
+		return err
+	}
+	// decisions outlive the contract
+	recorded, err := stores.Contract.ListBreakGlassDecisions(context.Background(), added.Id)
+	if err != nil {
+		return err
+	}
+	if len(recorded) != 2 || recorded[0].Check != "permission read" || recorded[1].Check != "permission write" ||
+		recorded[0].ID == "" || !recorded[0].DecidedAt.Equal(decidedAt) || recorded[0].SubjectID != subject.Id {
+		return fmt.Errorf("listed decisions %v, want the read and then the write decision", recorded)
+	}
+	return stores.Group.Delete(group.Id)
+}
+
+func checkCascadingDeletes(stores Stores) error {
+	subject, group, err := putSubjectAndGroup(stores)
+	if err != nil {
+		return err
+	}
+	if _, err = stores.Contract.AddNewContract(subject.Id, group.Id, contract.Window{}); err != nil {
+		return err
+	}
+	if err = stores.Subject.Delete(subject.Id); err != nil {
+		return err
+	}
+	contracts, err := contractsByGroupID(stores, group.Id)
+	if err != nil {
+		return err
+	}
+	if len(contracts) != 0 {
+		return fmt.Errorf("%d contracts survived the deletion of their subject", len(contracts))
+	}
+	return stores.Group.Delete(group.Id)
+}
+
+func checkRollback(stores Stores) error {
+	var subjectID string
+	rollbackErr := errors.Error("rollback")
+	err := stores.Subject.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		subject, err := stores.Subject.TxPut(tx, &pb.Subject{UserId: "conformance-rollback"})
+		if err != nil {
+			return err
+		}
+		subjectID = subject.Id
+		if _, err = stores.Group.TxPut(tx, &pb.Group{}); err != nil {
+			return err
+		}
+		return rollbackErr
+	})
+	if err != rollbackErr {
+		return fmt.Errorf("with tx returned %v, want the callback error", err)
+	}
+	if _, err = stores.Subject.Get(subjectID); err == nil {
+		return errors.Error("subject written in a failed transaction is readable")
+	}
+	return nil
+}
+
+func putSubjectAndGroup(stores Stores) (*pb.Subject, *pb.Group, error) {
+	subject, err := stores.Subject.Put(&pb.Subject{UserId: "conformance-contract"})
+	if err != nil {
+		return nil, nil, err
+	}
+	group, err := stores.Group.Put(&pb.Group{})
+	return subject, group, err
+}
+
+func contractsByGroupID(stores Stores, groupID string) (contracts []contract.Contract, err error) {
+	err = stores.Group.WithTx(context.Background(), func(tx store.SQLTransactional) error {
+		contracts, err = stores.Contract.TxListContractsByGroupID(tx, groupID)
+		return err
+	})
+	return
+}
@@ -0,0 +1,117 @@
+package storetest_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dlshle/authnz/internal/contract"
+	"github.com/dlshle/authnz/internal/group"
+	"github.com/dlshle/authnz/internal/migration"
+	"github.com/dlshle/authnz/internal/policy"
+	"github.com/dlshle/authnz/internal/storetest"
+	"github.com/dlshle/authnz/internal/subject"
+	"github.com/dlshle/authnz/pkg/store"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestMemory(t *testing.T) {
+	db := store.NewMemoryDB()
+	check(t, storetest.Stores{
+		Subject:  subject.NewMemoryStore(db),
+		Group:    group.NewMemoryStore(db),
+		Policy:   policy.NewMemoryStore(db),
+		Contract: contract.NewMemoryStore(db),
+		Entities: store.NewMemoryPBEntityStore(db, "entities"),
+	})
+}
+
+func TestKV(t *testing.T) {
+	db, err := store.OpenKVDB(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	check(t, storetest.Stores{
+		Subject:  subject.NewKVStore(db),
+		Group:    group.NewKVStore(db),
+		Policy:   policy.NewKVStore(db),
+		Contract: contract.NewKVStore(db),
+		Entities: store.NewKVPBEntityStore(db, "entities"),
+	})
+}
+
+func TestSQLite(t *testing.T) {
+	db := openSQLite(t)
+	check(t, storetest.Stores{
+		Subject:  subject.NewSQLStore(db),
+		Group:    group.NewSQLStore(db, store.ProtobufFormat),
+		Policy:   policy.NewSQLStore(db, store.ProtobufFormat),
+		Contract: contract.NewContractStore(db),
+		// roles is a plain entity table in the sqlite schema
+		Entities: store.NewSQLPBEntityStore(db, "roles"),
+	})
+}
+
+func check(t *testing.T, stores storetest.Stores) {
+	t.Helper()
+	if err := storetest.Check(stores); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// FuzzBulkGet checks that bulk gets with arbitrary ids, comma separated in the input, return exactly the
+// stored entities among them
+func FuzzBulkGet(f *testing.F) {
+	db := openSQLite(f)
+	entities := store.NewSQLPBEntityStore(db, "roles")
+	stored := map[string]bool{"a": true, "b": true, "' OR 1=1 --": true, "$1": true, "a\x00b": true}
+	for id := range stored {
+		if _, err := entities.Put(&store.PBEntity{ID: id, Payload: []byte(id)}); err != nil {
+			f.Fatal(err)
+		}
+	}
+	f.Add("a")
+	f.Add("a,a,b")
+	f.Add("' OR 1=1 --,c")
+	f.Add("$1,$2,?,')")
+	f.Add("a\x00b,a")
+	f.Fuzz(func(t *testing.T, input string) {
+		ids := strings.Split(input, ",")
+		// sqlite binds at most 32766 parameters
+		if len(ids) > 1000 {
+			ids = ids[:1000]
+		}
+		found, err := entities.TxBulkGet(db, ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := make(map[string]bool)
+		for _, id := range ids {
+			if stored[id] {
+				expected[id] = true
+			}
+		}
+		if len(found) != len(expected) {
+			t.Fatalf("got %d entities for %q, expected %d", len(found), ids, len(expected))
+		}
+		for _, entity := range found {
+			if !expected[entity.ID] || string(entity.Payload) != entity.ID {
+				t.Fatalf("unexpected entity %q for %q", entity.ID, ids)
+			}
+		}
+	})
+}
+
+func openSQLite(tb testing.TB) *sqlx.DB {
+	tb.Helper()
+	db, err := sqlx.Open(store.SQLiteDriverName, store.SQLiteDSN(filepath.Join(tb.TempDir(), "authnz.db")))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	if err = migration.ExecMigration(db); err != nil {
+		tb.Fatal(err)
+	}
+	return db
+}
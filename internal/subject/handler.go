@@ -2,26 +2,40 @@ package subject
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/dlshle/authnz/internal/contract"
 	"github.com/dlshle/authnz/internal/group"
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
 	"github.com/dlshle/gommon/logging"
 	"github.com/dlshle/gommon/utils"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxMembershipChanges = 1000
+	minPasswordLength    = 12
+	// bcrypt ignores everything past 72 bytes
+	maxPasswordLength = 72
 )
 
 type Handler struct {
 	store         Store
 	contractStore contract.Store
 	groupStore    group.Store
+	guard         contract.Guard
 	logger        logging.Logger
 }
 
-func NewHandler(store Store, contractStore contract.Store, groupStore group.Store) *Handler {
+func NewHandler(store Store, contractStore contract.Store, groupStore group.Store, guard contract.Guard) *Handler {
 	return &Handler{store: store,
 		contractStore: contractStore,
 		groupStore:    groupStore,
+		guard:         guard,
 		logger:        logging.GlobalLogger.WithPrefix("[SubjectHandler]")}
 }
 
@@ -36,64 +50,99 @@ func (h *Handler) AddSubject(ctx context.Context, req *pb.AddSubjectRequest) (*p
 }
 
 func (h *Handler) DeleteSubject(ctx context.Context, subjectID string) (*pb.EmptyResponse, error) {
-	err := h.store.WithTX(func(s store.SQLTransactional) error {
+	var contracts []contract.Contract
+	err := h.store.WithTX(ctx, func(s store.SQLTransactional) (err error) {
 		// 1. list all contracts about subject id
-		contracts, err := h.contractStore.TxListAllContractsBySubject(s, subjectID)
+		contracts, err = h.contractStore.TxListAllContractsBySubject(s, subjectID)
 		if err != nil {
 			h.logger.Errorf(ctx, "failed to list all contracts by %s due to %s", subjectID, err.Error())
 			return err
 		}
 
-		// 2. delete subject
+		// 2. delete subject, its contracts are deleted by the cascading foreign key
 		err = h.store.TxDelete(s, subjectID)
 		if err != nil {
 			h.logger.Errorf(ctx, "failed to delete subject %s due to %s", subjectID, err.Error())
-			return err
 		}
+		return err
+	})
+	if err != nil {
+		return &pb.EmptyResponse{}, err
+	}
 
-		// 3. check if any group from contracts has no reference other than this subject
-		for _, contract := range contracts {
-			err = h.contractStore.TxDeleteContract(s, contract.ID)
-			if err != nil {
-				h.logger.Warnf(ctx, "failed to delete contract %s due to %s", contract.ID, err.Error())
-			}
-			contractsByGroup, err := h.contractStore.TxListContractsByGroupID(s, contract.GroupID)
-			if err != nil {
-				h.logger.Warnf(ctx, "failed to list contracts by groupID %s due to %s", contract.GroupID, err.Error())
-				continue
-			}
-			if len(contractsByGroup) == 1 {
-				h.logger.Infof(ctx, "delete group by id %s due to zombie group", contract.GroupID)
-				err = h.groupStore.TxDelete(s, contract.GroupID)
-				if err != nil {
-					h.logger.Warnf(ctx, "failed to delete zombie group %s due to %s", contract.GroupID, err.Error())
-					continue
-				}
-			}
+	// 3. delete the groups from contracts that have no reference left, each in its own transaction since
+	// not every store can undo a failed delete without aborting the transaction around it
+	deleted := make(map[string]bool)
+	for _, contract := range contracts {
+		if deleted[contract.GroupID] {
+			continue
 		}
-		return nil
-	})
+		deleted[contract.GroupID] = true
+		if err := h.deleteZombieGroup(ctx, contract.GroupID); err != nil {
+			h.logger.Warnf(ctx, "failed to delete zombie group %s due to %s", contract.GroupID, err.Error())
+		}
+	}
+	return &pb.EmptyResponse{}, nil
+}
 
-	return &pb.EmptyResponse{}, err
+// deleteZombieGroup deletes the group if no contract references it
+func (h *Handler) deleteZombieGroup(ctx context.Context, groupID string) error {
+	return h.groupStore.WithTx(ctx, func(s store.SQLTransactional) error {
+		contractsByGroup, err := h.contractStore.TxListContractsByGroupID(s, groupID)
+		if err != nil || len(contractsByGroup) > 0 {
+			return err
+		}
+		h.logger.Infof(ctx, "delete group by id %s due to zombie group", groupID)
+		return h.groupStore.TxDelete(s, groupID)
+	})
 }
 
 func (h *Handler) GetSubjectByID(subjectID string) (*pb.Subject, error) {
 	return h.store.Get(subjectID)
 }
 
-func (h *Handler) FindSubjectsByUserID(userID string) ([]*pb.Subject, error) {
-	subjects := []Subject{}
-	err := h.store.WithTX(func(s store.SQLTransactional) error {
-		return s.Select(&subjects, "SELECT * FROM subjects WHERE user_id = $1", userID)
+// GetSubject may read from a read replica, GetSubjectByID reads the primary
+func (h *Handler) GetSubject(ctx context.Context, subjectID string) (subject *pb.Subject, err error) {
+	err = h.store.View(ctx, func(tx store.SQLTransactional) error {
+		subject, err = h.store.TxGet(tx, subjectID)
+		return err
 	})
+	return
+}
+
+func (h *Handler) FindSubjectsByUserID(ctx context.Context, userID string) ([]*pb.Subject, error) {
+	return h.store.FindByUserID(ctx, userID)
+}
+
+// SetPassword sets the password the subject signs in to the oidc provider with, only its bcrypt hash is stored
+func (h *Handler) SetPassword(ctx context.Context, subjectID, password string) (*pb.EmptyResponse, error) {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return nil, status.Error(codes.InvalidArgument, "password must have "+strconv.Itoa(minPasswordLength)+" to "+strconv.Itoa(maxPasswordLength)+" bytes")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
 	}
-	pbSubjects := make([]*pb.Subject, len(subjects), len(subjects))
-	for i, subject := range subjects {
-		pbSubjects[i] = &pb.Subject{Id: subject.ID, UserId: subject.UserID}
+	err = h.store.WithTX(ctx, func(tx store.SQLTransactional) error {
+		if _, err := h.store.TxGet(tx, subjectID); err != nil {
+			return err
+		}
+		return h.store.TxPutPasswordHash(tx, subjectID, string(passwordHash))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Infof(ctx, "password set for subject %s", subjectID)
+	return &pb.EmptyResponse{}, nil
+}
+
+// VerifyPassword fails when the subject has no password or password is not its password
+func (h *Handler) VerifyPassword(subjectID, password string) error {
+	passwordHash, err := h.store.GetPasswordHash(subjectID)
+	if err != nil {
+		return err
 	}
-	return pbSubjects, nil
+	return bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
 }
 
 func (h *Handler) CreateGroupsForSubjects(ctx context.Context, subjectIDs []string, attributes []*pb.Attribute) (*pb.CreateGroupForSubjectsResponse, error) {
@@ -103,7 +152,13 @@ func (h *Handler) CreateGroupsForSubjects(ctx context.Context, subjectIDs []stri
 		contracts []*pb.Contract
 		err       error
 	)
-	err = h.store.WithTX(func(tx store.SQLTransactional) error {
+	check, err := h.guard.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.WithTX(ctx, func(tx store.SQLTransactional) error {
+		// the transaction is run again on serialization failures
+		contracts = nil
 		return utils.ProcessWithErrors(func() error {
 			// get all subjects that exist
 			subjects, err = h.store.TxBulkGet(tx, subjectIDs)
@@ -115,7 +170,10 @@ func (h *Handler) CreateGroupsForSubjects(ctx context.Context, subjectIDs []stri
 		}, func() error {
 			// add contract for each subject
 			for _, subject := range subjects {
-				contract, err := h.contractStore.TxAddNewContract(tx, subject.Id, group.Id)
+				if err := check(tx, subject.Id, []string{group.Id}); err != nil {
+					return err
+				}
+				contract, err := h.contractStore.TxAddNewContract(tx, subject.Id, group.Id, contract.Window{})
 				if err != nil {
 					return err
 				}
@@ -132,12 +190,16 @@ func (h *Handler) CreateGroupsForSubjects(ctx context.Context, subjectIDs []stri
 
 func (h *Handler) AddSubjectWithAttributes(ctx context.Context, userID string, attributes []*pb.Attribute) (*pb.AddSubjectWithAttributesResponse, error) {
 	var (
-		group    *pb.Group
-		subject  *pb.Subject
-		contract *pb.Contract
-		err      error
+		group   *pb.Group
+		subject *pb.Subject
+		added   *pb.Contract
+		err     error
 	)
-	err = h.store.WithTX(func(tx store.SQLTransactional) error {
+	check, err := h.guard.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.WithTX(ctx, func(tx store.SQLTransactional) error {
 		return utils.ProcessWithErrors(func() error {
 			subject, err = h.store.TxPut(tx, &pb.Subject{UserId: userID})
 			return err
@@ -145,12 +207,158 @@ func (h *Handler) AddSubjectWithAttributes(ctx context.Context, userID string, a
 			group, err = h.groupStore.TxPut(tx, &pb.Group{Attributes: attributes})
 			return err
 		}, func() error {
-			contract, err = h.contractStore.TxAddNewContract(tx, subject.Id, group.Id)
+			// a group conflicts with itself when its attributes match several groups of a rule
+			return check(tx, subject.Id, []string{group.Id})
+		}, func() error {
+			added, err = h.contractStore.TxAddNewContract(tx, subject.Id, group.Id, contract.Window{})
 			return err
 		})
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &pb.AddSubjectWithAttributesResponse{Subject: subject, Group: group, ContractId: contract.Id}, nil
+	return &pb.AddSubjectWithAttributesResponse{Subject: subject, Group: group, ContractId: added.Id}, nil
+}
+
+// AddSubjectsToGroup adds a contract with window for each subject in one transaction, subjects that do not
+// exist, are already members or would break a separation of duties rule are reported in their results and
+// do not keep the others from being added
+func (h *Handler) AddSubjectsToGroup(ctx context.Context, groupID string, subjectIDs []string, window contract.Window) (*pb.GroupMembershipResponse, error) {
+	subjectIDs, err := membershipSubjectIDs(groupID, subjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	check, err := h.guard.Prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var results []*pb.SubjectMembershipResult
+	err = h.store.WithTX(ctx, func(tx store.SQLTransactional) error {
+		// the transaction is run again on serialization failures
+		results = make([]*pb.SubjectMembershipResult, 0, len(subjectIDs))
+		subjects, members, err := h.txMembershipState(tx, groupID, subjectIDs)
+		if err != nil {
+			return err
+		}
+		for _, subjectID := range subjectIDs {
+			result := &pb.SubjectMembershipResult{SubjectId: subjectID}
+			results = append(results, result)
+			// failures are found before writing since a failed write can not be undone on its own in every store
+			if !subjects[subjectID] {
+				setMembershipError(result, &store.ReferenceError{Msg: "subject not found for id " + subjectID})
+				continue
+			}
+			if _, isMember := members[subjectID]; isMember {
+				setMembershipError(result, &store.DuplicateError{Msg: "subject " + subjectID + " is already a member of group " + groupID})
+				continue
+			}
+			if err := check(tx, subjectID, []string{groupID}); err != nil {
+				if _, ok := status.FromError(err); !ok {
+					return err
+				}
+				setMembershipError(result, err)
+				continue
+			}
+			if result.Contract, err = h.contractStore.TxAddNewContract(tx, subjectID, groupID, window); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupMembershipResponse{Results: results}, nil
+}
+
+// RemoveSubjectsFromGroup deletes the contracts of the subjects with the group in one transaction, subjects
+// that are not members are reported in their results. The group is kept even when no member is left.
+func (h *Handler) RemoveSubjectsFromGroup(ctx context.Context, groupID string, subjectIDs []string) (*pb.GroupMembershipResponse, error) {
+	subjectIDs, err := membershipSubjectIDs(groupID, subjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	var results []*pb.SubjectMembershipResult
+	err = h.store.WithTX(ctx, func(tx store.SQLTransactional) error {
+		results = make([]*pb.SubjectMembershipResult, 0, len(subjectIDs))
+		_, members, err := h.txMembershipState(tx, groupID, subjectIDs)
+		if err != nil {
+			return err
+		}
+		for _, subjectID := range subjectIDs {
+			result := &pb.SubjectMembershipResult{SubjectId: subjectID}
+			results = append(results, result)
+			member, isMember := members[subjectID]
+			if !isMember {
+				setMembershipError(result, status.Error(codes.NotFound, "subject "+subjectID+" is not a member of group "+groupID))
+				continue
+			}
+			if err := h.contractStore.TxDeleteContract(tx, member.ID); err != nil {
+				return err
+			}
+			result.Contract = member.ToPB()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupMembershipResponse{Results: results}, nil
+}
+
+// txMembershipState returns which of the subjects exist and the group's contracts by subject id, the group
+// has to exist
+func (h *Handler) txMembershipState(tx store.SQLTransactional, groupID string, subjectIDs []string) (map[string]bool, map[string]contract.Contract, error) {
+	if _, err := h.groupStore.TxGet(tx, groupID); err != nil {
+		return nil, nil, err
+	}
+	subjects, err := h.store.TxBulkGet(tx, subjectIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]bool, len(subjects))
+	for _, subject := range subjects {
+		existing[subject.Id] = true
+	}
+	contracts, err := h.contractStore.TxListContractsByGroupID(tx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	members := make(map[string]contract.Contract, len(contracts))
+	for _, c := range contracts {
+		members[c.SubjectID] = c
+	}
+	return existing, members, nil
+}
+
+// membershipSubjectIDs validates a bulk membership change and returns its subject ids without duplicates,
+// in the order they were given
+func membershipSubjectIDs(groupID string, subjectIDs []string) ([]string, error) {
+	if groupID == "" {
+		return nil, errors.Error("group id is required")
+	}
+	seen := make(map[string]bool, len(subjectIDs))
+	ids := make([]string, 0, len(subjectIDs))
+	for _, subjectID := range subjectIDs {
+		if subjectID == "" {
+			return nil, errors.Error("subject ids can not be empty")
+		}
+		if !seen[subjectID] {
+			seen[subjectID] = true
+			ids = append(ids, subjectID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.Error("at least one subject id is required")
+	}
+	if len(ids) > maxMembershipChanges {
+		return nil, errors.Error("at most " + strconv.Itoa(maxMembershipChanges) + " subjects can be changed at once")
+	}
+	return ids, nil
+}
+
+func setMembershipError(result *pb.SubjectMembershipResult, err error) {
+	st := status.Convert(err)
+	result.Code = int32(st.Code())
+	result.Message = st.Message()
 }
@@ -0,0 +1,196 @@
+package subject
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const userIDIndex = "subjects_user_id_idx"
+
+type kvStore struct {
+	db *store.KVDB
+}
+
+func NewKVStore(db *store.KVDB) Store {
+	return &kvStore{db: db}
+}
+
+func (s *kvStore) Get(id string) (subject *pb.Subject, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		subject, err = s.TxGet(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *kvStore) TxGet(tx store.SQLTransactional, id string) (*pb.Subject, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	subject := Subject{}
+	found, err := kvTx.Get(subjectTable, id, &subject)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &store.NotFoundError{Msg: "subject not found for id " + id}
+	}
+	return &pb.Subject{Id: subject.ID, UserId: subject.UserID}, nil
+}
+
+func (s *kvStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Subject, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.getAll(kvTx, ids)
+}
+
+func (s *kvStore) FindByUserID(ctx context.Context, userID string) (pbSubjects []*pb.Subject, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		ids, err := kvTx.Lookup(userIDIndex, userID)
+		if err != nil {
+			return err
+		}
+		pbSubjects, err = s.getAll(kvTx, ids)
+		return err
+	})
+	return
+}
+
+func (s *kvStore) Put(subject *pb.Subject) (ret *pb.Subject, err error) {
+	err = s.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		ret, err = s.TxPut(tx, subject)
+		return err
+	})
+	return
+}
+
+func (s *kvStore) TxPut(tx store.SQLTransactional, subject *pb.Subject) (*pb.Subject, error) {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if subject.Id == "" {
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		subject.Id = newID.String()
+	}
+	previous := Subject{}
+	found, err := kvTx.Get(subjectTable, subject.Id, &previous)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if err = kvTx.RemoveIndex(userIDIndex, previous.UserID, previous.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err = kvTx.Put(subjectTable, subject.Id, Subject{ID: subject.Id, UserID: subject.UserId}); err != nil {
+		return nil, err
+	}
+	return subject, kvTx.AddIndex(userIDIndex, subject.UserId, subject.Id)
+}
+
+func (s *kvStore) Delete(id string) error {
+	return s.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		return s.TxDelete(tx, id)
+	})
+}
+
+func (s *kvStore) TxDelete(tx store.SQLTransactional, id string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	subject := Subject{}
+	found, err := kvTx.Get(subjectTable, id, &subject)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Error("subject not found for id " + id)
+	}
+	if err = kvTx.RemoveIndex(userIDIndex, subject.UserID, id); err != nil {
+		return err
+	}
+	if _, err = kvTx.Delete(passwordTable, id); err != nil {
+		return err
+	}
+	_, err = kvTx.Delete(subjectTable, id)
+	return err
+}
+
+func (s *kvStore) WithTX(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *kvStore) getAll(tx *store.KVTx, ids []string) ([]*pb.Subject, error) {
+	pbSubjects := []*pb.Subject{}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		subject := Subject{}
+		found, err := tx.Get(subjectTable, id, &subject)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			pbSubjects = append(pbSubjects, &pb.Subject{Id: subject.ID, UserId: subject.UserID})
+		}
+	}
+	return pbSubjects, nil
+}
+
+func (s *kvStore) View(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.ViewContext(ctx, cb)
+}
+
+func (s *kvStore) TxPutPasswordHash(tx store.SQLTransactional, subjectID, passwordHash string) error {
+	kvTx, err := store.AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	found, err := kvTx.Get(subjectTable, subjectID, &Subject{})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &store.ReferenceError{Constraint: "subject_passwords_subject_id_fkey", Msg: "password of subject " + subjectID + " references a missing record"}
+	}
+	return kvTx.Put(passwordTable, subjectID, Password{SubjectID: subjectID, PasswordHash: passwordHash})
+}
+
+func (s *kvStore) GetPasswordHash(subjectID string) (passwordHash string, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		kvTx, err := store.AsKVTx(tx)
+		if err != nil {
+			return err
+		}
+		password := Password{}
+		found, err := kvTx.Get(passwordTable, subjectID, &password)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return &store.NotFoundError{Msg: "no password found for subject " + subjectID}
+		}
+		passwordHash = password.PasswordHash
+		return nil
+	})
+	return
+}
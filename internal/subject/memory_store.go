@@ -0,0 +1,160 @@
+package subject
+
+import (
+	"context"
+
+	"github.com/dlshle/authnz/pkg/store"
+	pb "github.com/dlshle/authnz/proto"
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	subjectTable  = "subjects"
+	passwordTable = "subject_passwords"
+)
+
+type memoryStore struct {
+	db *store.MemoryDB
+}
+
+func NewMemoryStore(db *store.MemoryDB) Store {
+	return &memoryStore{db: db}
+}
+
+func (s *memoryStore) Get(id string) (subject *pb.Subject, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		subject, err = s.TxGet(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *memoryStore) TxGet(tx store.SQLTransactional, id string) (*pb.Subject, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	row, ok := memoryTx.Get(subjectTable, id)
+	if !ok {
+		return nil, &store.NotFoundError{Msg: "subject not found for id " + id}
+	}
+	subject := row.(Subject)
+	return &pb.Subject{Id: subject.ID, UserId: subject.UserID}, nil
+}
+
+func (s *memoryStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Subject, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	pbSubjects := []*pb.Subject{}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if row, ok := memoryTx.Get(subjectTable, id); ok {
+			subject := row.(Subject)
+			pbSubjects = append(pbSubjects, &pb.Subject{Id: subject.ID, UserId: subject.UserID})
+		}
+	}
+	return pbSubjects, nil
+}
+
+func (s *memoryStore) FindByUserID(ctx context.Context, userID string) (pbSubjects []*pb.Subject, err error) {
+	err = s.db.ViewContext(ctx, func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		pbSubjects = []*pb.Subject{}
+		for _, row := range memoryTx.Scan(subjectTable, func(row interface{}) bool {
+			return row.(Subject).UserID == userID
+		}) {
+			subject := row.(Subject)
+			pbSubjects = append(pbSubjects, &pb.Subject{Id: subject.ID, UserId: subject.UserID})
+		}
+		return nil
+	})
+	return
+}
+
+func (s *memoryStore) Put(subject *pb.Subject) (ret *pb.Subject, err error) {
+	err = s.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		ret, err = s.TxPut(tx, subject)
+		return err
+	})
+	return
+}
+
+func (s *memoryStore) TxPut(tx store.SQLTransactional, subject *pb.Subject) (*pb.Subject, error) {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if subject.Id == "" {
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		subject.Id = newID.String()
+	}
+	memoryTx.Put(subjectTable, subject.Id, Subject{ID: subject.Id, UserID: subject.UserId})
+	return subject, nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	return s.WithTX(context.Background(), func(tx store.SQLTransactional) error {
+		return s.TxDelete(tx, id)
+	})
+}
+
+func (s *memoryStore) TxDelete(tx store.SQLTransactional, id string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	if !memoryTx.Delete(subjectTable, id) {
+		return errors.Error("subject not found for id " + id)
+	}
+	memoryTx.Delete(passwordTable, id)
+	return nil
+}
+
+func (s *memoryStore) WithTX(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *memoryStore) View(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return s.db.ViewContext(ctx, cb)
+}
+
+func (s *memoryStore) TxPutPasswordHash(tx store.SQLTransactional, subjectID, passwordHash string) error {
+	memoryTx, err := store.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := memoryTx.Get(subjectTable, subjectID); !ok {
+		return &store.ReferenceError{Constraint: "subject_passwords_subject_id_fkey", Msg: "password of subject " + subjectID + " references a missing record"}
+	}
+	memoryTx.Put(passwordTable, subjectID, Password{SubjectID: subjectID, PasswordHash: passwordHash})
+	return nil
+}
+
+func (s *memoryStore) GetPasswordHash(subjectID string) (passwordHash string, err error) {
+	err = s.db.View(func(tx store.SQLTransactional) error {
+		memoryTx, err := store.AsMemoryTx(tx)
+		if err != nil {
+			return err
+		}
+		row, ok := memoryTx.Get(passwordTable, subjectID)
+		if !ok {
+			return &store.NotFoundError{Msg: "no password found for subject " + subjectID}
+		}
+		passwordHash = row.(Password).PasswordHash
+		return nil
+	})
+	return
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/dlshle/authnz/pkg/store"
 	pb "github.com/dlshle/authnz/proto"
-	"github.com/dlshle/gommon/logging"
 	"github.com/dlshle/gommon/utils"
 	"github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
@@ -15,32 +14,48 @@ type Store interface {
 	Get(id string) (*pb.Subject, error)
 	TxGet(tx store.SQLTransactional, id string) (*pb.Subject, error)
 	TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Subject, error)
+	// FindByUserID may read from a read replica when the store has any
+	FindByUserID(ctx context.Context, userID string) ([]*pb.Subject, error)
 	Delete(id string) error
 	TxDelete(tx store.SQLTransactional, id string) error
 	Put(subject *pb.Subject) (*pb.Subject, error)
 	TxPut(tx store.SQLTransactional, subject *pb.Subject) (ret *pb.Subject, err error)
-	WithTX(cb func(store.SQLTransactional) error) error
+	WithTX(ctx context.Context, cb func(store.SQLTransactional) error) error
+	// View runs cb with a handle for reads that tolerate replication lag, or in the transaction carried by ctx
+	View(ctx context.Context, cb func(store.SQLTransactional) error) error
+	TxPutPasswordHash(tx store.SQLTransactional, subjectID, passwordHash string) error
+	// GetPasswordHash fails with a store.NotFoundError when the subject has no password
+	GetPasswordHash(subjectID string) (string, error)
 }
 
 type SQLSubjectStore struct {
-	db *sqlx.DB
+	db       *sqlx.DB
+	replicas *store.ReplicaSet
 }
 
 func NewSQLStore(db *sqlx.DB) Store {
 	return &SQLSubjectStore{db: db}
 }
 
+// NewReplicatedSQLStore writes to the primary of replicas and serves View and FindByUserID from its read replicas
+func NewReplicatedSQLStore(replicas *store.ReplicaSet) Store {
+	return &SQLSubjectStore{db: replicas.Primary(), replicas: replicas}
+}
+
 func (s *SQLSubjectStore) Get(id string) (*pb.Subject, error) {
 	return s.TxGet(s.db, id)
 }
 
 func (s *SQLSubjectStore) TxGet(tx store.SQLTransactional, id string) (*pb.Subject, error) {
-	subject := &Subject{}
-	err := tx.Select(subject, "SELECT * FROM subjects WHERE id = $1", id)
+	subjects := []Subject{}
+	err := tx.Select(&subjects, "SELECT * FROM subjects WHERE id = $1", id)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Subject{Id: subject.ID, UserId: subject.UserID}, nil
+	if len(subjects) == 0 {
+		return nil, &store.NotFoundError{Msg: "subject not found for id " + id}
+	}
+	return &pb.Subject{Id: subjects[0].ID, UserId: subjects[0].UserID}, nil
 }
 
 func (s *SQLSubjectStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]*pb.Subject, error) {
@@ -48,19 +63,33 @@ func (s *SQLSubjectStore) TxBulkGet(tx store.SQLTransactional, ids []string) ([]
 	if len(ids) == 0 {
 		return []*pb.Subject{}, nil
 	}
-	sql := "SELECT * FROM subjects WHERE id in " + store.MakeInQueryClause(ids)
-	logging.GlobalLogger.Infof(context.Background(), "tx bulk get query: %s", sql)
-	err := tx.Select(&subjects, sql)
+	inClause, args := store.MakeInParams(ids, 1)
+	err := tx.Select(&subjects, "SELECT * FROM subjects WHERE id IN "+inClause, args...)
 	if err != nil {
 		return nil, err
 	}
 	pbSubjects := make([]*pb.Subject, len(subjects), len(subjects))
 	for i, subject := range subjects {
-		pbSubjects[i] = &pb.Subject{Id: subject.ID, UserId: subject.ID}
+		pbSubjects[i] = &pb.Subject{Id: subject.ID, UserId: subject.UserID}
 	}
 	return pbSubjects, nil
 }
 
+func (s *SQLSubjectStore) FindByUserID(ctx context.Context, userID string) (pbSubjects []*pb.Subject, err error) {
+	err = s.View(ctx, func(tx store.SQLTransactional) error {
+		subjects := []Subject{}
+		if err := tx.Select(&subjects, "SELECT * FROM subjects WHERE user_id = $1", userID); err != nil {
+			return err
+		}
+		pbSubjects = make([]*pb.Subject, len(subjects), len(subjects))
+		for i, subject := range subjects {
+			pbSubjects[i] = &pb.Subject{Id: subject.ID, UserId: subject.UserID}
+		}
+		return nil
+	})
+	return
+}
+
 func (s *SQLSubjectStore) Put(subject *pb.Subject) (ret *pb.Subject, err error) {
 	return s.TxPut(s.db, subject)
 }
@@ -97,6 +126,34 @@ func (s *SQLSubjectStore) TxDelete(tx store.SQLTransactional, id string) error {
 	return store.CheckErrorForRowsAffected(res, "subject not found for id "+id)
 }
 
-func (s *SQLSubjectStore) WithTX(cb func(store.SQLTransactional) error) error {
-	return store.WithSQLXTx(s.db, cb)
+func (s *SQLSubjectStore) WithTX(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	return store.WithSQLXTxContext(ctx, s.db, cb)
+}
+
+func (s *SQLSubjectStore) View(ctx context.Context, cb func(store.SQLTransactional) error) error {
+	var reader store.SQLTransactional = s.db
+	if s.replicas != nil {
+		reader = s.replicas.Reader(ctx)
+	}
+	return cb(reader)
+}
+
+func (s *SQLSubjectStore) TxPutPasswordHash(tx store.SQLTransactional, subjectID, passwordHash string) error {
+	res, err := tx.Exec("INSERT INTO subject_passwords (subject_id, password_hash) VALUES ($1, $2) ON CONFLICT (subject_id) DO UPDATE SET password_hash = $2", subjectID, passwordHash)
+	if err != nil {
+		return store.TranslateError(err, "password of subject "+subjectID)
+	}
+	return store.CheckErrorForRowsAffected(res, "password of subject "+subjectID+" is not saved")
+}
+
+func (s *SQLSubjectStore) GetPasswordHash(subjectID string) (string, error) {
+	passwords := []Password{}
+	err := s.db.Select(&passwords, "SELECT * FROM subject_passwords WHERE subject_id = $1", subjectID)
+	if err != nil {
+		return "", err
+	}
+	if len(passwords) == 0 {
+		return "", &store.NotFoundError{Msg: "no password found for subject " + subjectID}
+	}
+	return passwords[0].PasswordHash, nil
 }
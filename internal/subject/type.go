@@ -4,3 +4,9 @@ type Subject struct {
 	ID     string `db:"id"`
 	UserID string `db:"user_id"`
 }
+
+// Password holds the bcrypt hash of the password a subject signs in with
+type Password struct {
+	SubjectID    string `db:"subject_id"`
+	PasswordHash string `db:"password_hash"`
+}
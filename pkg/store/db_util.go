@@ -1,35 +1,29 @@
 package store
 
 import (
+	"strconv"
 	"strings"
-
-	"github.com/jmoiron/sqlx"
 )
 
-func WithSQLXTx(db *sqlx.DB, cb func(SQLTransactional) error) error {
-	tx, err := db.Beginx()
-	if err != nil {
-		return err
-	}
-	err = cb(tx)
-	if err != nil {
-		return err
-	}
-	return tx.Commit()
-}
-
-func MakeInQueryClause(elements []string) string {
+// MakeInParams returns an IN list of bound parameters for elements, numbered from $firstParam, and the matching args.
+// Duplicates are dropped, elements are never spliced into the query.
+func MakeInParams(elements []string, firstParam int) (string, []interface{}) {
 	var clauseBuilder strings.Builder
+	args := make([]interface{}, 0, len(elements))
+	seen := make(map[string]bool, len(elements))
 	clauseBuilder.WriteRune('(')
-	l := len(elements)
-	for i, ele := range elements {
-		clauseBuilder.WriteRune('\'')
-		clauseBuilder.WriteString(ele)
-		clauseBuilder.WriteRune('\'')
-		if i < l-1 {
+	for _, ele := range elements {
+		if seen[ele] {
+			continue
+		}
+		seen[ele] = true
+		if len(args) > 0 {
 			clauseBuilder.WriteRune(',')
 		}
+		clauseBuilder.WriteRune('$')
+		clauseBuilder.WriteString(strconv.Itoa(firstParam + len(args)))
+		args = append(args, ele)
 	}
 	clauseBuilder.WriteRune(')')
-	return clauseBuilder.String()
+	return clauseBuilder.String(), args
 }
@@ -1,6 +1,161 @@
 package store
 
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/dlshle/gommon/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PBEntity holds a message as a protojson document in Document, and as protobuf bytes in Payload unless it is
+// written in the jsonb format.
+// Version starts at 1 and is incremented by every write, writing a non-zero Version only succeeds if it is still current.
 type PBEntity struct {
-	ID      string `db:"id"`
-	Payload []byte `db:"payload"`
+	ID       string `db:"id"`
+	Payload  []byte `db:"payload"`
+	Document []byte `db:"document"`
+	Version  int64  `db:"version"`
+}
+
+// PayloadFormat decides how messages are written, rows in either format can always be read
+type PayloadFormat string
+
+const (
+	ProtobufFormat PayloadFormat = "protobuf"
+	// JSONBFormat needs postgres, documents are indexed and can be filtered in sql
+	JSONBFormat PayloadFormat = "jsonb"
+)
+
+func ParsePayloadFormat(format string) (PayloadFormat, error) {
+	switch PayloadFormat(format) {
+	case "", ProtobufFormat:
+		return ProtobufFormat, nil
+	case JSONBFormat:
+		return JSONBFormat, nil
+	}
+	return "", errors.Error("unknown payload format " + format)
+}
+
+// EncodePBEntity takes the entity version from the int64 version field of message if it has one
+func EncodePBEntity(id string, message proto.Message, format PayloadFormat) (*PBEntity, error) {
+	entity := &PBEntity{ID: id}
+	if field := versionField(message); field != nil {
+		entity.Version = message.ProtoReflect().Get(field).Int()
+	}
+	// documents are written in either format so that FindByDocument can rely on them
+	var err error
+	if entity.Document, err = protojson.Marshal(message); err != nil {
+		return nil, err
+	}
+	if format != JSONBFormat {
+		entity.Payload, err = proto.Marshal(message)
+	}
+	return entity, err
+}
+
+// DecodePBEntity sets the int64 version field of message to the entity version if it has one
+func DecodePBEntity(entity *PBEntity, message proto.Message) error {
+	// both are written together, the payload is cheaper to decode
+	var err error
+	if len(entity.Payload) > 0 {
+		err = proto.Unmarshal(entity.Payload, message)
+	} else {
+		err = protojson.Unmarshal(entity.Document, message)
+	}
+	if err != nil {
+		return err
+	}
+	if field := versionField(message); field != nil {
+		message.ProtoReflect().Set(field, protoreflect.ValueOfInt64(entity.Version))
+	}
+	return nil
+}
+
+func versionField(message proto.Message) protoreflect.FieldDescriptor {
+	field := message.ProtoReflect().Descriptor().Fields().ByName("version")
+	if field == nil || field.Kind() != protoreflect.Int64Kind || field.Cardinality() == protoreflect.Repeated {
+		return nil
+	}
+	return field
+}
+
+// nextVersion applies the compare-and-swap rules of TxPut for stores that check versions in go
+func nextVersion(id string, expected, current int64, exists bool) (int64, error) {
+	if expected == 0 && exists {
+		return 0, newConflictError(id, expected, current)
+	}
+	if expected == 0 {
+		return 1, nil
+	}
+	if !exists {
+		return 0, errors.Error("no record found for " + id)
+	}
+	if current != expected {
+		return 0, newConflictError(id, expected, current)
+	}
+	return current + 1, nil
+}
+
+func newConflictError(id string, expected, current int64) error {
+	return &ConflictError{Msg: id + " has been modified, expected version " + strconv.FormatInt(expected, 10) + " but found " + strconv.FormatInt(current, 10)}
+}
+
+// newDocumentFilter evaluates jsonb containment for stores that filter documents in go, entities without a
+// document always pass so that the result is at worst a superset
+func newDocumentFilter(containment []byte) (func(entity *PBEntity) (bool, error), error) {
+	var want interface{}
+	if err := json.Unmarshal(containment, &want); err != nil {
+		return nil, err
+	}
+	return func(entity *PBEntity) (bool, error) {
+		if entity.Document == nil {
+			return true, nil
+		}
+		var have interface{}
+		if err := json.Unmarshal(entity.Document, &have); err != nil {
+			return false, err
+		}
+		return containsJSON(have, want), nil
+	}, nil
+}
+
+// containsJSON follows the rules of jsonb @>: an object contains the keys of want with contained values, an array
+// contains every element of want and scalars contain equal scalars
+func containsJSON(have, want interface{}) bool {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		haveObject, ok := have.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range want {
+			if haveValue, ok := haveObject[key]; !ok || !containsJSON(haveValue, value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		haveArray, ok := have.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, value := range want {
+			found := false
+			for _, element := range haveArray {
+				if containsJSON(element, value) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return have == want
+	}
 }
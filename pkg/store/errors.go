@@ -0,0 +1,103 @@
+package store
+
+import (
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// DuplicateError is returned when a write violates a unique constraint
+type DuplicateError struct {
+	Constraint string
+	Msg        string
+}
+
+func (e *DuplicateError) Error() string {
+	return e.Msg
+}
+
+func (e *DuplicateError) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Msg)
+}
+
+// NotFoundError is returned when a looked up record does not exist
+type NotFoundError struct {
+	Msg string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *NotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Msg)
+}
+
+// ReferenceError is returned when a write points at a row that does not exist
+type ReferenceError struct {
+	Constraint string
+	Msg        string
+}
+
+func (e *ReferenceError) Error() string {
+	return e.Msg
+}
+
+func (e *ReferenceError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Msg)
+}
+
+// ConflictError is returned when a compare-and-swap write finds a newer version than the one it read
+type ConflictError struct {
+	Msg string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Msg
+}
+
+func (e *ConflictError) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.Msg)
+}
+
+// TranslateError maps constraint violations to typed errors with msg, other errors are returned as is
+func TranslateError(err error, msg string) error {
+	switch driverErr := err.(type) {
+	case *pq.Error:
+		switch driverErr.Code {
+		case pgUniqueViolation:
+			return &DuplicateError{Constraint: driverErr.Constraint, Msg: msg + " already exists"}
+		case pgForeignKeyViolation:
+			return &ReferenceError{Constraint: driverErr.Constraint, Msg: msg + " references a missing record"}
+		}
+	case sqlite3.Error:
+		// sqlite does not report constraint names
+		switch driverErr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+			return &DuplicateError{Msg: msg + " already exists"}
+		case sqlite3.ErrConstraintForeignKey:
+			return &ReferenceError{Msg: msg + " references a missing record"}
+		}
+	}
+	return err
+}
+
+// isSerializationFailure reports whether the transaction lost against a concurrent one and can be run again
+func isSerializationFailure(err error) bool {
+	switch driverErr := err.(type) {
+	case *pq.Error:
+		return driverErr.Code == pgSerializationFailure || driverErr.Code == pgDeadlockDetected
+	case sqlite3.Error:
+		return driverErr.Code == sqlite3.ErrBusy || driverErr.Code == sqlite3.ErrLocked
+	}
+	return false
+}
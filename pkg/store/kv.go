@@ -0,0 +1,296 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dlshle/gommon/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// KVDB is an embedded bbolt database shared by the kv stores so they can join the same transaction.
+// Every table is a bucket keyed by primary key, secondary indexes are buckets of <len(value)><value><primary key>
+// entries, whose names end with _idx.
+type KVDB struct {
+	db       *bolt.DB
+	cascades map[string][]func(tx *KVTx, parentKey string) error
+}
+
+// OpenKVDB opens (or creates) authnz.db inside dataDir
+func OpenKVDB(dataDir string) (*KVDB, error) {
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(filepath.Join(dataDir, "authnz.db"), 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if err = migrateIndexFormat(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &KVDB{db: db, cascades: make(map[string][]func(tx *KVTx, parentKey string) error)}, nil
+}
+
+const (
+	metaBucket        = "authnz_meta"
+	indexFormatKey    = "index_format"
+	indexFormat       = "2"
+	indexBucketSuffix = "_idx"
+)
+
+// migrateIndexFormat rewrites index entries of the first format, <value>\x00<primary key>, which could not
+// tell values containing \x00 apart. Indexed values never contained \x00 in practice, so the first one splits
+// the entries.
+func migrateIndexFormat(db *bolt.DB) error {
+	return db.Update(func(boltTx *bolt.Tx) error {
+		meta, err := boltTx.CreateBucketIfNotExists([]byte(metaBucket))
+		if err != nil {
+			return err
+		}
+		if string(meta.Get([]byte(indexFormatKey))) == indexFormat {
+			return nil
+		}
+		err = boltTx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			if !strings.HasSuffix(string(name), indexBucketSuffix) {
+				return nil
+			}
+			var entries [][]byte
+			if err := bucket.ForEach(func(entry, _ []byte) error {
+				entries = append(entries, append([]byte{}, entry...))
+				return nil
+			}); err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				separator := bytes.IndexByte(entry, 0)
+				if separator < 0 {
+					continue
+				}
+				if err := bucket.Delete(entry); err != nil {
+					return err
+				}
+				if err := bucket.Put(indexEntry(string(entry[:separator]), string(entry[separator+1:])), []byte{}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return meta.Put([]byte(indexFormatKey), []byte(indexFormat))
+	})
+}
+
+func (db *KVDB) Close() error {
+	return db.db.Close()
+}
+
+// RegisterCascade runs onDelete in the same transaction whenever a row of parentTable is deleted, like ON DELETE CASCADE
+func (db *KVDB) RegisterCascade(parentTable string, onDelete func(tx *KVTx, parentKey string) error) {
+	db.cascades[parentTable] = append(db.cascades[parentTable], onDelete)
+}
+
+// WithTx runs cb in a read-write transaction that is rolled back when cb fails
+func (db *KVDB) WithTx(cb func(SQLTransactional) error) error {
+	return db.WithTxContext(context.Background(), cb)
+}
+
+// WithTxContext joins the transaction carried by ctx. bbolt has no savepoints, so a failing nested callback
+// dooms the whole transaction even when the caller recovers from the error.
+func (db *KVDB) WithTxContext(ctx context.Context, cb func(SQLTransactional) error) error {
+	if tx, ok := txFromContext(ctx, db).(*KVTx); ok {
+		if !tx.tx.Writable() {
+			return errors.Error("can not write in a read-only kv transaction")
+		}
+		err := cb(tx)
+		if err != nil && tx.doomed == nil {
+			tx.doomed = err
+		}
+		return err
+	}
+	return db.db.Update(func(boltTx *bolt.Tx) error {
+		tx := &KVTx{tx: boltTx, db: db}
+		tx.ctx = contextWithTx(ctx, db, tx)
+		if err := cb(tx); err != nil {
+			return err
+		}
+		return tx.doomed
+	})
+}
+
+func (db *KVDB) View(cb func(SQLTransactional) error) error {
+	return db.ViewContext(context.Background(), cb)
+}
+
+// ViewContext runs cb in a read-only transaction, or in the transaction carried by ctx. Read-only transactions
+// do not take the writer lock of bbolt.
+func (db *KVDB) ViewContext(ctx context.Context, cb func(SQLTransactional) error) error {
+	if tx, ok := txFromContext(ctx, db).(*KVTx); ok {
+		return cb(tx)
+	}
+	return db.db.View(func(boltTx *bolt.Tx) error {
+		tx := &KVTx{tx: boltTx, db: db}
+		tx.ctx = contextWithTx(ctx, db, tx)
+		return cb(tx)
+	})
+}
+
+// KVTx satisfies SQLTransactional so it can be passed through the Tx* store methods, raw sql is not supported
+type KVTx struct {
+	tx     *bolt.Tx
+	db     *KVDB
+	ctx    context.Context
+	doomed error
+}
+
+func AsKVTx(tx SQLTransactional) (*KVTx, error) {
+	kvTx, ok := tx.(*KVTx)
+	if !ok {
+		return nil, errors.Error("kv store requires a kv transaction")
+	}
+	return kvTx, nil
+}
+
+func (tx *KVTx) Select(dest interface{}, query string, args ...interface{}) error {
+	return errors.Error("raw sql is not supported by the kv store")
+}
+
+func (tx *KVTx) Exec(query string, args ...any) (sql.Result, error) {
+	return nil, errors.Error("raw sql is not supported by the kv store")
+}
+
+func (tx *KVTx) Context() context.Context {
+	return tx.ctx
+}
+
+// bucket returns the bucket to write to, creating it on the first write
+func (tx *KVTx) bucket(name string) (*bolt.Bucket, error) {
+	return tx.tx.CreateBucketIfNotExists([]byte(name))
+}
+
+// readBucket returns nil for tables that were never written, reads do not create buckets so they can run
+// in read-only transactions
+func (tx *KVTx) readBucket(name string) *bolt.Bucket {
+	return tx.tx.Bucket([]byte(name))
+}
+
+// GetRaw returns a copy of the stored value, nil when the key does not exist
+func (tx *KVTx) GetRaw(table, key string) ([]byte, error) {
+	bucket := tx.readBucket(table)
+	if bucket == nil {
+		return nil, nil
+	}
+	// bucket.Get can not tell an empty value from a missing key
+	found, value := bucket.Cursor().Seek([]byte(key))
+	if found == nil || string(found) != key {
+		return nil, nil
+	}
+	return append([]byte{}, value...), nil
+}
+
+func (tx *KVTx) PutRaw(table, key string, value []byte) error {
+	bucket, err := tx.bucket(table)
+	if err != nil {
+		return err
+	}
+	return bucket.Put([]byte(key), value)
+}
+
+// Get decodes the json row stored under key into row, it reports false when the key does not exist
+func (tx *KVTx) Get(table, key string, row interface{}) (bool, error) {
+	value, err := tx.GetRaw(table, key)
+	if err != nil || value == nil {
+		return false, err
+	}
+	return true, json.Unmarshal(value, row)
+}
+
+func (tx *KVTx) Put(table, key string, row interface{}) error {
+	value, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	return tx.PutRaw(table, key, value)
+}
+
+// Delete removes key and runs the cascades registered for table, it reports false when the key does not exist
+func (tx *KVTx) Delete(table, key string) (bool, error) {
+	bucket := tx.readBucket(table)
+	if bucket == nil {
+		return false, nil
+	}
+	if found, _ := bucket.Cursor().Seek([]byte(key)); found == nil || string(found) != key {
+		return false, nil
+	}
+	if err := bucket.Delete([]byte(key)); err != nil {
+		return false, err
+	}
+	for _, onDelete := range tx.db.cascades[table] {
+		if err := onDelete(tx, key); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Scan calls cb with every row of table in key order
+func (tx *KVTx) Scan(table string, cb func(key string, value []byte) error) error {
+	bucket := tx.readBucket(table)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(key, value []byte) error {
+		return cb(string(key), value)
+	})
+}
+
+// indexPrefix length-prefixes value so that no value is a prefix of another one's entries
+func indexPrefix(value string) []byte {
+	prefix := make([]byte, 4, 4+len(value))
+	binary.BigEndian.PutUint32(prefix, uint32(len(value)))
+	return append(prefix, value...)
+}
+
+func indexEntry(value, key string) []byte {
+	return append(indexPrefix(value), key...)
+}
+
+func (tx *KVTx) AddIndex(index, value, key string) error {
+	bucket, err := tx.bucket(index)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(indexEntry(value, key), []byte{})
+}
+
+func (tx *KVTx) RemoveIndex(index, value, key string) error {
+	bucket := tx.readBucket(index)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.Delete(indexEntry(value, key))
+}
+
+// Lookup returns the primary keys indexed under value in key order
+func (tx *KVTx) Lookup(index, value string) ([]string, error) {
+	keys := []string{}
+	bucket := tx.readBucket(index)
+	if bucket == nil {
+		return keys, nil
+	}
+	prefix := indexPrefix(value)
+	cursor := bucket.Cursor()
+	for entry, _ := cursor.Seek(prefix); entry != nil && bytes.HasPrefix(entry, prefix); entry, _ = cursor.Next() {
+		keys = append(keys, string(entry[len(prefix):]))
+	}
+	return keys, nil
+}
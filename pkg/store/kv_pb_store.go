@@ -0,0 +1,262 @@
+package store
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+type KVPBEntityStore struct {
+	db        *KVDB
+	tableName string
+}
+
+func NewKVPBEntityStore(db *KVDB, tableName string) PBEntityStore {
+	return &KVPBEntityStore{db: db, tableName: tableName}
+}
+
+func (s *KVPBEntityStore) Get(id string) (entity *PBEntity, err error) {
+	err = s.db.View(func(tx SQLTransactional) error {
+		entity, err = s.TxGet(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *KVPBEntityStore) TxGet(tx SQLTransactional, id string) (*PBEntity, error) {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := s.get(kvTx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, errors.Error("no record found for " + id)
+	}
+	return entity, nil
+}
+
+func (s *KVPBEntityStore) TxBulkGet(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	entities := []*PBEntity{}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		entity, err := s.get(kvTx, id)
+		if err != nil {
+			return nil, err
+		}
+		if entity != nil {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, nil
+}
+
+func (s *KVPBEntityStore) Put(entity *PBEntity) (ret *PBEntity, err error) {
+	err = s.WithTx(context.Background(), func(tx SQLTransactional) error {
+		ret, err = s.TxPut(tx, entity)
+		return err
+	})
+	return
+}
+
+func (s *KVPBEntityStore) TxPut(tx SQLTransactional, entity *PBEntity) (*PBEntity, error) {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if entity.ID == "" {
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		entity.ID = newID.String()
+	}
+	var current int64
+	previous, err := s.get(kvTx, entity.ID)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		current = previous.Version
+	}
+	version, err := nextVersion(entity.ID, entity.Version, current, previous != nil)
+	if err != nil {
+		return entity, err
+	}
+	// bbolt rejects nil values
+	payload := entity.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+	if err = kvTx.PutRaw(s.tableName, entity.ID, payload); err != nil {
+		return nil, err
+	}
+	if err = kvTx.PutRaw(s.versionTable(), entity.ID, []byte(strconv.FormatInt(version, 10))); err != nil {
+		return nil, err
+	}
+	if entity.Document != nil {
+		err = kvTx.PutRaw(s.documentTable(), entity.ID, entity.Document)
+	} else {
+		_, err = kvTx.Delete(s.documentTable(), entity.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	entity.Version = version
+	return entity, nil
+}
+
+// TxBulkPut puts every entity with the semantics of TxPut and stops at the first failure
+func (s *KVPBEntityStore) TxBulkPut(tx SQLTransactional, entities []*PBEntity) ([]*PBEntity, error) {
+	for _, entity := range entities {
+		if _, err := s.TxPut(tx, entity); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+func (s *KVPBEntityStore) Delete(id string) error {
+	return s.WithTx(context.Background(), func(tx SQLTransactional) error {
+		return s.TxDelete(tx, id)
+	})
+}
+
+func (s *KVPBEntityStore) TxDelete(tx SQLTransactional, id string) error {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	if _, err = kvTx.Delete(s.versionTable(), id); err != nil {
+		return err
+	}
+	if _, err = kvTx.Delete(s.documentTable(), id); err != nil {
+		return err
+	}
+	deleted, err := kvTx.Delete(s.tableName, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.Error(id + " is not found")
+	}
+	return nil
+}
+
+// TxBulkDelete checks every id before deleting any so a failure leaves the table untouched
+// TxBulkGetForUpdate is TxBulkGet, write transactions run one at a time
+func (s *KVPBEntityStore) TxBulkGetForUpdate(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	return s.TxBulkGet(tx, ids)
+}
+
+func (s *KVPBEntityStore) TxBulkDelete(tx SQLTransactional, ids []string) error {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		payload, err := kvTx.GetRaw(s.tableName, id)
+		if err != nil {
+			return err
+		}
+		if payload == nil {
+			return errors.Error(id + " is not found")
+		}
+	}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if err = s.TxDelete(kvTx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *KVPBEntityStore) WithTx(ctx context.Context, cb func(SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *KVPBEntityStore) View(ctx context.Context, cb func(SQLTransactional) error) error {
+	return s.db.ViewContext(ctx, cb)
+}
+
+func (s *KVPBEntityStore) FindByDocument(containment []byte) (entities []*PBEntity, err error) {
+	err = s.db.View(func(tx SQLTransactional) error {
+		entities, err = s.TxFindByDocument(tx, containment)
+		return err
+	})
+	return
+}
+
+func (s *KVPBEntityStore) TxFindByDocument(tx SQLTransactional, containment []byte) ([]*PBEntity, error) {
+	kvTx, err := AsKVTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := newDocumentFilter(containment)
+	if err != nil {
+		return nil, err
+	}
+	entities := []*PBEntity{}
+	err = kvTx.Scan(s.tableName, func(key string, value []byte) error {
+		entity, err := s.get(kvTx, key)
+		if err != nil {
+			return err
+		}
+		matched, err := matches(entity)
+		if matched {
+			entities = append(entities, entity)
+		}
+		return err
+	})
+	return entities, err
+}
+
+// versions and documents are kept in sibling buckets so the table holds the plain payload
+func (s *KVPBEntityStore) versionTable() string {
+	return s.tableName + "_versions"
+}
+
+// documentTable has no entry for entities written before documents were kept, FindByDocument returns them
+// until they are written again
+func (s *KVPBEntityStore) documentTable() string {
+	return s.tableName + "_documents"
+}
+
+// get returns nil when the entity does not exist
+func (s *KVPBEntityStore) get(tx *KVTx, id string) (*PBEntity, error) {
+	payload, err := tx.GetRaw(s.tableName, id)
+	if err != nil || payload == nil {
+		return nil, err
+	}
+	entity := &PBEntity{ID: id, Payload: payload}
+	version, err := tx.GetRaw(s.versionTable(), id)
+	if err != nil {
+		return nil, err
+	}
+	if version != nil {
+		if entity.Version, err = strconv.ParseInt(string(version), 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if entity.Document, err = tx.GetRaw(s.documentTable(), id); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
@@ -0,0 +1,250 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+
+	"github.com/dlshle/gommon/errors"
+)
+
+// MemoryDB is an in-process database shared by the in-memory stores so they can join the same transaction.
+// Write transactions are serialized and run alone, read-only ones run concurrently with each other. Every write
+// records an undo entry that is replayed when the callback fails.
+type MemoryDB struct {
+	mutex    sync.RWMutex
+	tables   map[string]map[string]interface{}
+	cascades map[string][]cascade
+	// children of each table, to keep the references of their rows current on writes
+	parents map[string][]parentLink
+	// keys of the child rows that reference a parent row
+	references map[reference]map[string]bool
+}
+
+// cascade deletes rows of childTable that reference a deleted row of the parent table, like ON DELETE CASCADE
+type cascade struct {
+	childTable string
+}
+
+type parentLink struct {
+	parentTable string
+	parentKey   func(row interface{}) string
+}
+
+type reference struct {
+	parentTable string
+	parentKey   string
+	childTable  string
+}
+
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		tables:     make(map[string]map[string]interface{}),
+		cascades:   make(map[string][]cascade),
+		parents:    make(map[string][]parentLink),
+		references: make(map[reference]map[string]bool),
+	}
+}
+
+// RegisterCascade deletes the rows of childTable whose parentKey is the key of a deleted row of parentTable
+func (db *MemoryDB) RegisterCascade(parentTable, childTable string, parentKey func(row interface{}) string) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	db.cascades[parentTable] = append(db.cascades[parentTable], cascade{childTable: childTable})
+	link := parentLink{parentTable: parentTable, parentKey: parentKey}
+	db.parents[childTable] = append(db.parents[childTable], link)
+	for key, row := range db.tables[childTable] {
+		db.reference(childTable, key, link, row, true)
+	}
+}
+
+func (db *MemoryDB) WithTx(cb func(SQLTransactional) error) error {
+	return db.WithTxContext(context.Background(), cb)
+}
+
+// WithTxContext joins the transaction carried by ctx, a failing nested callback only undoes its own writes
+func (db *MemoryDB) WithTxContext(ctx context.Context, cb func(SQLTransactional) error) (err error) {
+	tx, nested := txFromContext(ctx, db).(*MemoryTx)
+	if nested && tx.readOnly {
+		return errors.Error("can not write in a read-only in-memory transaction")
+	}
+	if !nested {
+		db.mutex.Lock()
+		defer db.mutex.Unlock()
+		tx = &MemoryTx{db: db}
+		tx.ctx = contextWithTx(ctx, db, tx)
+	}
+	savepoint := len(tx.undo)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tx.rollbackTo(savepoint)
+			panic(recovered)
+		}
+		if err != nil {
+			tx.rollbackTo(savepoint)
+		}
+	}()
+	return cb(tx)
+}
+
+func (db *MemoryDB) View(cb func(SQLTransactional) error) error {
+	return db.ViewContext(context.Background(), cb)
+}
+
+// ViewContext runs cb in a read-only transaction, or in the transaction carried by ctx
+func (db *MemoryDB) ViewContext(ctx context.Context, cb func(SQLTransactional) error) error {
+	if tx, nested := txFromContext(ctx, db).(*MemoryTx); nested {
+		return cb(tx)
+	}
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+	tx := &MemoryTx{db: db, readOnly: true}
+	tx.ctx = contextWithTx(ctx, db, tx)
+	return cb(tx)
+}
+
+// write stores row under key, or deletes the key when row is nil, and keeps the references of the row current
+func (db *MemoryDB) write(table, key string, row interface{}) {
+	rows, ok := db.tables[table]
+	if !ok {
+		rows = make(map[string]interface{})
+		db.tables[table] = rows
+	}
+	if previous, existed := rows[key]; existed {
+		for _, link := range db.parents[table] {
+			db.reference(table, key, link, previous, false)
+		}
+	}
+	if row == nil {
+		delete(rows, key)
+		return
+	}
+	rows[key] = row
+	for _, link := range db.parents[table] {
+		db.reference(table, key, link, row, true)
+	}
+}
+
+func (db *MemoryDB) reference(childTable, childKey string, link parentLink, row interface{}, add bool) {
+	ref := reference{parentTable: link.parentTable, parentKey: link.parentKey(row), childTable: childTable}
+	children, ok := db.references[ref]
+	if add {
+		if !ok {
+			children = make(map[string]bool)
+			db.references[ref] = children
+		}
+		children[childKey] = true
+		return
+	}
+	delete(children, childKey)
+	if ok && len(children) == 0 {
+		delete(db.references, ref)
+	}
+}
+
+// MemoryTx satisfies SQLTransactional so it can be passed through the Tx* store methods, raw sql is not supported
+type MemoryTx struct {
+	db       *MemoryDB
+	ctx      context.Context
+	readOnly bool
+	undo     []func()
+}
+
+func AsMemoryTx(tx SQLTransactional) (*MemoryTx, error) {
+	memoryTx, ok := tx.(*MemoryTx)
+	if !ok {
+		return nil, errors.Error("in-memory store requires an in-memory transaction")
+	}
+	return memoryTx, nil
+}
+
+func (tx *MemoryTx) Select(dest interface{}, query string, args ...interface{}) error {
+	return errors.Error("raw sql is not supported by the in-memory store")
+}
+
+func (tx *MemoryTx) Exec(query string, args ...any) (sql.Result, error) {
+	return nil, errors.Error("raw sql is not supported by the in-memory store")
+}
+
+func (tx *MemoryTx) Context() context.Context {
+	return tx.ctx
+}
+
+func (tx *MemoryTx) Get(table, key string) (interface{}, bool) {
+	row, ok := tx.db.tables[table][key]
+	return row, ok
+}
+
+func (tx *MemoryTx) Put(table, key string, row interface{}) {
+	tx.mustWrite()
+	previous, existed := tx.db.tables[table][key]
+	tx.db.write(table, key, row)
+	tx.undo = append(tx.undo, func() {
+		if existed {
+			tx.db.write(table, key, previous)
+		} else {
+			tx.db.write(table, key, nil)
+		}
+	})
+}
+
+func (tx *MemoryTx) Delete(table, key string) bool {
+	tx.mustWrite()
+	previous, existed := tx.db.tables[table][key]
+	if !existed {
+		return false
+	}
+	tx.db.write(table, key, nil)
+	tx.undo = append(tx.undo, func() {
+		tx.db.write(table, key, previous)
+	})
+	for _, c := range tx.db.cascades[table] {
+		children := tx.db.references[reference{parentTable: table, parentKey: key, childTable: c.childTable}]
+		childKeys := make([]string, 0, len(children))
+		for childKey := range children {
+			childKeys = append(childKeys, childKey)
+		}
+		sort.Strings(childKeys)
+		for _, childKey := range childKeys {
+			tx.Delete(c.childTable, childKey)
+		}
+	}
+	return true
+}
+
+// mustWrite panics on writes in read-only transactions, which are bugs of the calling store
+func (tx *MemoryTx) mustWrite() {
+	if tx.readOnly {
+		panic("write in a read-only in-memory transaction")
+	}
+}
+
+// Keys returns the keys of a table in a stable order
+func (tx *MemoryTx) Keys(table string) []string {
+	rows := tx.db.tables[table]
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Scan returns the rows matching filter in key order
+func (tx *MemoryTx) Scan(table string, filter func(row interface{}) bool) []interface{} {
+	var rows []interface{}
+	for _, key := range tx.Keys(table) {
+		if row, _ := tx.Get(table, key); filter(row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (tx *MemoryTx) rollbackTo(savepoint int) {
+	for i := len(tx.undo) - 1; i >= savepoint; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = tx.undo[:savepoint]
+}
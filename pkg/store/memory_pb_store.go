@@ -0,0 +1,188 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dlshle/gommon/errors"
+	"github.com/gofrs/uuid"
+)
+
+type MemoryPBEntityStore struct {
+	db        *MemoryDB
+	tableName string
+}
+
+func NewMemoryPBEntityStore(db *MemoryDB, tableName string) PBEntityStore {
+	return &MemoryPBEntityStore{db: db, tableName: tableName}
+}
+
+func (s *MemoryPBEntityStore) Get(id string) (entity *PBEntity, err error) {
+	err = s.db.View(func(tx SQLTransactional) error {
+		entity, err = s.TxGet(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *MemoryPBEntityStore) TxGet(tx SQLTransactional, id string) (*PBEntity, error) {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	row, ok := memoryTx.Get(s.tableName, id)
+	if !ok {
+		return nil, errors.Error("no record found for " + id)
+	}
+	return copyPBEntity(row.(*PBEntity)), nil
+}
+
+func (s *MemoryPBEntityStore) TxBulkGet(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	entities := []*PBEntity{}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if row, ok := memoryTx.Get(s.tableName, id); ok {
+			entities = append(entities, copyPBEntity(row.(*PBEntity)))
+		}
+	}
+	return entities, nil
+}
+
+func (s *MemoryPBEntityStore) Put(entity *PBEntity) (ret *PBEntity, err error) {
+	err = s.WithTx(context.Background(), func(tx SQLTransactional) error {
+		ret, err = s.TxPut(tx, entity)
+		return err
+	})
+	return
+}
+
+func (s *MemoryPBEntityStore) TxPut(tx SQLTransactional, entity *PBEntity) (*PBEntity, error) {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if entity.ID == "" {
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		entity.ID = newID.String()
+	}
+	var current int64
+	previous, exists := memoryTx.Get(s.tableName, entity.ID)
+	if exists {
+		current = previous.(*PBEntity).Version
+	}
+	version, err := nextVersion(entity.ID, entity.Version, current, exists)
+	if err != nil {
+		return entity, err
+	}
+	entity.Version = version
+	memoryTx.Put(s.tableName, entity.ID, copyPBEntity(entity))
+	return entity, nil
+}
+
+// TxBulkPut puts every entity with the semantics of TxPut and stops at the first failure
+func (s *MemoryPBEntityStore) TxBulkPut(tx SQLTransactional, entities []*PBEntity) ([]*PBEntity, error) {
+	for _, entity := range entities {
+		if _, err := s.TxPut(tx, entity); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+func (s *MemoryPBEntityStore) Delete(id string) error {
+	return s.WithTx(context.Background(), func(tx SQLTransactional) error {
+		return s.TxDelete(tx, id)
+	})
+}
+
+func (s *MemoryPBEntityStore) TxDelete(tx SQLTransactional, id string) error {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	if !memoryTx.Delete(s.tableName, id) {
+		return errors.Error(id + " is not found")
+	}
+	return nil
+}
+
+// TxBulkDelete checks every id before deleting any so a failure leaves the table untouched
+// TxBulkGetForUpdate is TxBulkGet, write transactions run one at a time
+func (s *MemoryPBEntityStore) TxBulkGetForUpdate(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	return s.TxBulkGet(tx, ids)
+}
+
+func (s *MemoryPBEntityStore) TxBulkDelete(tx SQLTransactional, ids []string) error {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, ok := memoryTx.Get(s.tableName, id); !ok {
+			return errors.Error(id + " is not found")
+		}
+	}
+	for _, id := range ids {
+		memoryTx.Delete(s.tableName, id)
+	}
+	return nil
+}
+
+func (s *MemoryPBEntityStore) WithTx(ctx context.Context, cb func(SQLTransactional) error) error {
+	return s.db.WithTxContext(ctx, cb)
+}
+
+func (s *MemoryPBEntityStore) View(ctx context.Context, cb func(SQLTransactional) error) error {
+	return s.db.ViewContext(ctx, cb)
+}
+
+func (s *MemoryPBEntityStore) FindByDocument(containment []byte) (entities []*PBEntity, err error) {
+	err = s.db.View(func(tx SQLTransactional) error {
+		entities, err = s.TxFindByDocument(tx, containment)
+		return err
+	})
+	return
+}
+
+func (s *MemoryPBEntityStore) TxFindByDocument(tx SQLTransactional, containment []byte) ([]*PBEntity, error) {
+	memoryTx, err := AsMemoryTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := newDocumentFilter(containment)
+	if err != nil {
+		return nil, err
+	}
+	entities := []*PBEntity{}
+	for _, row := range memoryTx.Scan(s.tableName, func(row interface{}) bool {
+		return true
+	}) {
+		entity := row.(*PBEntity)
+		matched, err := matches(entity)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			entities = append(entities, copyPBEntity(entity))
+		}
+	}
+	return entities, nil
+}
+
+func copyPBEntity(entity *PBEntity) *PBEntity {
+	copied := &PBEntity{ID: entity.ID, Payload: append([]byte{}, entity.Payload...), Version: entity.Version}
+	if entity.Document != nil {
+		copied.Document = append([]byte{}, entity.Document...)
+	}
+	return copied
+}
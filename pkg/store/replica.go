@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dlshle/gommon/logging"
+	"github.com/jmoiron/sqlx"
+)
+
+// replicaLagQuery reports how far a postgres standby is behind in seconds, a standby that replayed
+// everything it received is not lagging even when the primary has been idle for a while
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// ReplicaSet routes reads that tolerate replication lag to read replicas and everything else to the primary.
+// A replica is only used while its lag is within maxStaleness, and a session that wrote within the
+// read-your-writes window keeps reading from the primary.
+type ReplicaSet struct {
+	primary       *sqlx.DB
+	replicas      []*replica
+	next          uint32
+	maxStaleness  time.Duration
+	sessionWindow time.Duration
+	mutex         sync.Mutex
+	lastWrites    map[string]time.Time
+}
+
+type replica struct {
+	db *sqlx.DB
+	// replication lag in nanoseconds, negative until the first successful check or when the replica is unreachable
+	lag int64
+}
+
+type sessionKey struct{}
+
+// NewReplicaSet with no replicas always reads from the primary
+func NewReplicaSet(primary *sqlx.DB, replicas []*sqlx.DB, maxStaleness, sessionWindow time.Duration) *ReplicaSet {
+	replicaSet := &ReplicaSet{
+		primary:       primary,
+		replicas:      make([]*replica, len(replicas), len(replicas)),
+		maxStaleness:  maxStaleness,
+		sessionWindow: sessionWindow,
+		lastWrites:    make(map[string]time.Time),
+	}
+	for i, db := range replicas {
+		replicaSet.replicas[i] = &replica{db: db, lag: -1}
+	}
+	return replicaSet
+}
+
+func (r *ReplicaSet) Primary() *sqlx.DB {
+	return r.primary
+}
+
+// WithSession tags ctx with the session (e.g. the caller) whose writes must be visible to its following reads
+func WithSession(ctx context.Context, session string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+// MarkWrite pins the session of ctx to the primary for the read-your-writes window
+func (r *ReplicaSet) MarkWrite(ctx context.Context) {
+	session, ok := ctx.Value(sessionKey{}).(string)
+	if !ok || len(r.replicas) == 0 {
+		return
+	}
+	now := time.Now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.lastWrites[session] = now
+	// forget sessions whose window has passed so the map does not grow with every caller
+	for key, lastWrite := range r.lastWrites {
+		if now.Sub(lastWrite) > r.sessionWindow {
+			delete(r.lastWrites, key)
+		}
+	}
+}
+
+// Reader returns the transaction on the primary carried by ctx, a replica that is fresh enough for ctx, or the primary
+func (r *ReplicaSet) Reader(ctx context.Context) SQLTransactional {
+	if tx := txFromContext(ctx, r.primary); tx != nil {
+		return tx
+	}
+	if len(r.replicas) == 0 || r.wroteRecently(ctx) {
+		return &sqlxDB{DB: r.primary, ctx: ctx}
+	}
+	start := atomic.AddUint32(&r.next, 1)
+	for i := range r.replicas {
+		candidate := r.replicas[(int(start)+i)%len(r.replicas)]
+		lag := atomic.LoadInt64(&candidate.lag)
+		if lag >= 0 && time.Duration(lag) <= r.maxStaleness {
+			return &sqlxDB{DB: candidate.db, ctx: ctx}
+		}
+	}
+	return &sqlxDB{DB: r.primary, ctx: ctx}
+}
+
+func (r *ReplicaSet) wroteRecently(ctx context.Context) bool {
+	session, ok := ctx.Value(sessionKey{}).(string)
+	if !ok {
+		return false
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	lastWrite, ok := r.lastWrites[session]
+	return ok && time.Since(lastWrite) <= r.sessionWindow
+}
+
+// MonitorLag measures the lag of every replica each interval until ctx is done
+func (r *ReplicaSet) MonitorLag(ctx context.Context, interval time.Duration) {
+	if len(r.replicas) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, replica := range r.replicas {
+			r.checkLag(ctx, replica, interval)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *ReplicaSet) checkLag(ctx context.Context, replica *replica, timeout time.Duration) {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var seconds float64
+	if err := replica.db.GetContext(checkCtx, &seconds, replicaLagQuery); err != nil {
+		if atomic.SwapInt64(&replica.lag, -1) >= 0 {
+			logging.GlobalLogger.Warnf(ctx, "read replica is not used until it is reachable again: %s", err.Error())
+		}
+		return
+	}
+	atomic.StoreInt64(&replica.lag, int64(seconds*float64(time.Second)))
+}
+
+func (r *ReplicaSet) Close() error {
+	for _, replica := range r.replicas {
+		if err := replica.db.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
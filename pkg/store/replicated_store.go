@@ -0,0 +1,18 @@
+package store
+
+import "context"
+
+type replicatedSQLPBEntityStore struct {
+	*SQLPBEntityStore
+	replicas *ReplicaSet
+}
+
+// NewReplicatedSQLPBEntityStore writes to the primary of replicas and serves View from a read replica that is
+// fresh enough for the context
+func NewReplicatedSQLPBEntityStore(replicas *ReplicaSet, tableName string) PBEntityStore {
+	return &replicatedSQLPBEntityStore{SQLPBEntityStore: &SQLPBEntityStore{Db: replicas.Primary(), tableName: tableName}, replicas: replicas}
+}
+
+func (s *replicatedSQLPBEntityStore) View(ctx context.Context, cb func(SQLTransactional) error) error {
+	return cb(s.replicas.Reader(ctx))
+}
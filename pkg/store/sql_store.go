@@ -3,6 +3,9 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/dlshle/gommon/errors"
 	"github.com/dlshle/gommon/logging"
@@ -10,6 +13,9 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// entityColumns are selected by name, tables may carry further columns for indexed lookups
+const entityColumns = "id, payload, document, version"
+
 type SQLPBEntityStore struct {
 	Db        *sqlx.DB
 	tableName string
@@ -33,7 +39,7 @@ func (s *SQLPBEntityStore) Get(id string) (*PBEntity, error) {
 
 func (s *SQLPBEntityStore) TxGet(tx SQLTransactional, id string) (*PBEntity, error) {
 	entities := []PBEntity{}
-	err := tx.Select(&entities, "SELECT * FROM "+s.tableName+" WHERE id = $1", id)
+	err := tx.Select(&entities, "SELECT "+entityColumns+" FROM "+s.tableName+" WHERE id = $1", id)
 	if err != nil {
 		return nil, err
 	}
@@ -44,23 +50,64 @@ func (s *SQLPBEntityStore) TxGet(tx SQLTransactional, id string) (*PBEntity, err
 }
 
 func (s *SQLPBEntityStore) TxBulkGet(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	return s.bulkGet(tx, ids, "")
+}
+
+func (s *SQLPBEntityStore) TxBulkGetForUpdate(tx SQLTransactional, ids []string) ([]*PBEntity, error) {
+	// sqlite transactions take the write lock when they begin and already exclude each other
+	if s.Db.DriverName() == SQLiteDriverName {
+		return s.bulkGet(tx, ids, "")
+	}
+	return s.bulkGet(tx, ids, " FOR UPDATE")
+}
+
+func (s *SQLPBEntityStore) bulkGet(tx SQLTransactional, ids []string, lock string) ([]*PBEntity, error) {
 	entities := []PBEntity{}
 	if len(ids) == 0 {
 		return []*PBEntity{}, nil
 	}
-	sql := "SELECT * FROM " + s.tableName + " WHERE id IN " + MakeInQueryClause(ids)
-	logging.GlobalLogger.Infof(context.Background(), "query sql: %s", sql)
-	err := tx.Select(&entities, sql)
+	inClause, args := MakeInParams(ids, 1)
+	err := tx.Select(&entities, "SELECT "+entityColumns+" FROM "+s.tableName+" WHERE id IN "+inClause+lock, args...)
 	if err != nil {
 		return nil, err
 	}
 	pbEntities := make([]*PBEntity, len(entities), len(entities))
-	for i, entity := range entities {
-		pbEntities[i] = &entity
+	for i := range entities {
+		pbEntities[i] = &entities[i]
 	}
 	return pbEntities, err
 }
 
+// TxBulkPut puts every entity with the semantics of TxPut and stops at the first failure
+func (s *SQLPBEntityStore) TxBulkPut(tx SQLTransactional, entities []*PBEntity) ([]*PBEntity, error) {
+	for _, entity := range entities {
+		if _, err := s.TxPut(tx, entity); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// TxBulkDelete deletes the entities in one statement and fails when any of them does not exist
+func (s *SQLPBEntityStore) TxBulkDelete(tx SQLTransactional, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	inClause, args := MakeInParams(ids, 1)
+	res, err := tx.Exec("DELETE FROM "+s.tableName+" WHERE id IN "+inClause, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < int64(len(args)) {
+		return errors.Error(strconv.FormatInt(int64(len(args))-rowsAffected, 10) + " of the records to delete are not found")
+	}
+	return nil
+}
+
 func (s *SQLPBEntityStore) Delete(id string) error {
 	return s.TxDelete(s.Db, id)
 }
@@ -77,42 +124,118 @@ func (s *SQLPBEntityStore) Put(entity *PBEntity) (*PBEntity, error) {
 	return s.TxPut(s.Db, entity)
 }
 
+// TxPut creates or overwrites the entity when its version is 0, otherwise it only updates the entity if the version still matches
 func (s *SQLPBEntityStore) TxPut(tx SQLTransactional, entity *PBEntity) (*PBEntity, error) {
-	var (
-		result sql.Result
-		err    error
-	)
 	if entity.ID == "" {
-		// create
-		var newID uuid.UUID
-		newID, err = uuid.NewV4()
+		newID, err := uuid.NewV4()
 		if err != nil {
 			return nil, err
 		}
 		entity.ID = newID.String()
-		result, err = s.execUpsert(tx, entity)
+	}
+	var document interface{}
+	if entity.Document != nil {
+		document = string(entity.Document)
+	}
+	versions := []int64{}
+	var err error
+	if entity.Version == 0 {
+		// an existing row returns nothing and is reported as a conflict
+		err = tx.Select(&versions, "INSERT INTO "+s.tableName+" (id, payload, document, version) VALUES ($1, $2, $3, 1) ON CONFLICT (id) DO NOTHING RETURNING version",
+			entity.ID, entity.Payload, document)
 	} else {
-		result, err = s.execUpsert(tx, entity)
+		err = tx.Select(&versions, "UPDATE "+s.tableName+" SET payload = $2, document = $3, version = version + 1 WHERE id = $1 AND version = $4 RETURNING version",
+			entity.ID, entity.Payload, document, entity.Version)
 	}
 	if err != nil {
 		logging.GlobalLogger.Infof(context.Background(), "error: %v", err)
 		return entity, err
 	}
-	err = CheckErrorForRowsAffected(result, "no row is affected")
-	return entity, err
+	if len(versions) == 0 {
+		return entity, s.explainMissedUpdate(tx, entity)
+	}
+	entity.Version = versions[0]
+	return entity, nil
+}
 
+func (s *SQLPBEntityStore) explainMissedUpdate(tx SQLTransactional, entity *PBEntity) error {
+	versions := []int64{}
+	if err := tx.Select(&versions, "SELECT version FROM "+s.tableName+" WHERE id = $1", entity.ID); err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return errors.Error("no record found for " + entity.ID)
+	}
+	return newConflictError(entity.ID, entity.Version, versions[0])
+}
+
+func (s *SQLPBEntityStore) WithTx(ctx context.Context, cb func(SQLTransactional) error) error {
+	return WithSQLXTxContext(ctx, s.Db, cb)
+}
+
+func (s *SQLPBEntityStore) View(ctx context.Context, cb func(SQLTransactional) error) error {
+	return cb(sqlxReader(ctx, s.Db))
 }
 
-func (s *SQLPBEntityStore) WithTx(cb func(SQLTransactional) error) error {
-	return WithSQLXTx(s.Db, cb)
+func (s *SQLPBEntityStore) FindByDocument(containment []byte) ([]*PBEntity, error) {
+	return s.TxFindByDocument(s.Db, containment)
 }
 
-func (s *SQLPBEntityStore) execUpsert(tx SQLTransactional, entity *PBEntity) (sql.Result, error) {
-	return tx.Exec("INSERT INTO "+s.tableName+" (id, payload) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET id = $1, payload = $2", entity.ID, entity.Payload)
+func (s *SQLPBEntityStore) TxFindByDocument(tx SQLTransactional, containment []byte) ([]*PBEntity, error) {
+	entities := []PBEntity{}
+	if s.Db.DriverName() != SQLiteDriverName {
+		// every row has a document since migration 14
+		if err := tx.Select(&entities, "SELECT "+entityColumns+" FROM "+s.tableName+" WHERE document @> $1", string(containment)); err != nil {
+			return nil, err
+		}
+		pbEntities := make([]*PBEntity, len(entities), len(entities))
+		for i := range entities {
+			pbEntities[i] = &entities[i]
+		}
+		return pbEntities, nil
+	}
+	matches, err := newDocumentFilter(containment)
+	if err != nil {
+		return nil, err
+	}
+	predicate, args := sqliteDocumentPredicate(containment)
+	if err = tx.Select(&entities, "SELECT "+entityColumns+" FROM "+s.tableName+" WHERE "+predicate, args...); err != nil {
+		return nil, err
+	}
+	pbEntities := []*PBEntity{}
+	for i := range entities {
+		matched, err := matches(&entities[i])
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			pbEntities = append(pbEntities, &entities[i])
+		}
+	}
+	return pbEntities, nil
 }
 
-func (s *SQLPBEntityStore) execUpdate(tx SQLTransactional, entity *PBEntity) (sql.Result, error) {
-	return tx.Exec("UPDATE "+s.tableName+" SET payload = $2 WHERE id = $1", entity.ID, entity.Payload)
+// sqliteDocumentPredicate narrows the rows by the top level scalars of containment with json_extract, nested values
+// are left to the document filter. Rows written before documents were kept have none and are returned
+func sqliteDocumentPredicate(containment []byte) (string, []interface{}) {
+	predicate, args := "TRUE", []interface{}{}
+	want := map[string]interface{}{}
+	if err := json.Unmarshal(containment, &want); err != nil {
+		return predicate, args
+	}
+	for key, value := range want {
+		switch value.(type) {
+		case string, float64, bool:
+		default:
+			continue
+		}
+		if strings.ContainsAny(key, `"\`) {
+			continue
+		}
+		predicate += " AND (document IS NULL OR json_extract(document, ?) = ?)"
+		args = append(args, `$."`+key+`"`, value)
+	}
+	return predicate, args
 }
 
 func CheckErrorForRowsAffected(result sql.Result, onNoRowAffectedMsg string) error {
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// SQLiteDriverName is a sqlite3 driver that accepts the postgres style $n placeholders used by the stores.
+// sqlite numbers $n parameters by first appearance, so they are rewritten to ?n which binds the n-th argument.
+const SQLiteDriverName = "authnz-sqlite3"
+
+var dollarPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+func init() {
+	sql.Register(SQLiteDriverName, &sqliteDriver{})
+}
+
+// SQLiteDSN enables foreign keys, WAL and immediate transactions so concurrent writers wait instead of failing
+func SQLiteDSN(path string) string {
+	return "file:" + path + "?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=5000&_txlock=immediate"
+}
+
+func rebindSQLite(query string) string {
+	return dollarPlaceholder.ReplaceAllString(query, "?$1")
+}
+
+type sqliteDriver struct {
+	sqlite3.SQLiteDriver
+}
+
+func (d *sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{SQLiteConn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+type sqliteConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c *sqliteConn) Prepare(query string) (driver.Stmt, error) {
+	return c.SQLiteConn.Prepare(rebindSQLite(query))
+}
+
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.SQLiteConn.PrepareContext(ctx, rebindSQLite(query))
+}
+
+func (c *sqliteConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.SQLiteConn.ExecContext(ctx, rebindSQLite(query), args)
+}
+
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.SQLiteConn.QueryContext(ctx, rebindSQLite(query), args)
+}
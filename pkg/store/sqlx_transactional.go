@@ -1,8 +1,20 @@
 package store
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+)
 
 type SQLTransactional interface {
 	Select(dest interface{}, query string, args ...interface{}) error
 	Exec(query string, args ...any) (sql.Result, error)
 }
+
+// TxContext returns the context of tx, a WithTx call made with it joins tx through a savepoint.
+// Handles that are not transactions, like a plain *sqlx.DB, yield context.Background().
+func TxContext(tx SQLTransactional) context.Context {
+	if contextual, ok := tx.(interface{ Context() context.Context }); ok {
+		return contextual.Context()
+	}
+	return context.Background()
+}
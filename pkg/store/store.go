@@ -1,12 +1,29 @@
 package store
 
+import "context"
+
 type PBEntityStore interface {
 	Get(id string) (*PBEntity, error)
 	TxGet(SQLTransactional, string) (*PBEntity, error)
 	TxBulkGet(tx SQLTransactional, ids []string) ([]*PBEntity, error)
+	// TxBulkGetForUpdate is TxBulkGet that also locks the rows until tx ends, backends whose write transactions
+	// exclude each other do not need to lock
+	TxBulkGetForUpdate(tx SQLTransactional, ids []string) ([]*PBEntity, error)
 	Put(*PBEntity) (*PBEntity, error)
+	// TxPut inserts the entity when its version is 0 and otherwise updates the row of that version, it returns a
+	// ConflictError when the row exists with another version
 	TxPut(SQLTransactional, *PBEntity) (*PBEntity, error)
+	// TxBulkPut puts every entity with the semantics of TxPut
+	TxBulkPut(tx SQLTransactional, entities []*PBEntity) ([]*PBEntity, error)
 	Delete(id string) error
 	TxDelete(SQLTransactional, string) error
-	WithTx(cb func(SQLTransactional) error) error
+	// TxBulkDelete fails when any of the ids does not exist, the transaction is expected to be rolled back then
+	TxBulkDelete(tx SQLTransactional, ids []string) error
+	WithTx(ctx context.Context, cb func(SQLTransactional) error) error
+	// View runs cb with a handle for reads that tolerate replication lag, or in the transaction carried by ctx
+	View(ctx context.Context, cb func(SQLTransactional) error) error
+	// FindByDocument returns the entities whose json document contains containment like jsonb @> does,
+	// entities written before documents were kept have none and are returned as well
+	FindByDocument(containment []byte) ([]*PBEntity, error)
+	TxFindByDocument(tx SQLTransactional, containment []byte) ([]*PBEntity, error)
 }
@@ -0,0 +1,173 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dlshle/gommon/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+type TxOptions struct {
+	// Isolation of the outermost transaction, sql.LevelDefault keeps the database default
+	Isolation sql.IsolationLevel
+	// MaxRetries is how many times a transaction failing with a serialization failure or deadlock is run again,
+	// callbacks must therefore not leak partial results into captured variables
+	MaxRetries int
+}
+
+// DefaultTxOptions applies to every sql transaction, it is set once from the database config at startup
+var DefaultTxOptions = TxOptions{MaxRetries: 3}
+
+const retryBackoff = 20 * time.Millisecond
+
+// ParseIsolationLevel accepts the sql names of the isolation levels, e.g. "read committed", an empty level keeps the default
+func ParseIsolationLevel(level string) (sql.IsolationLevel, error) {
+	if level == "" {
+		return sql.LevelDefault, nil
+	}
+	normalized := strings.ReplaceAll(strings.ToLower(level), "_", " ")
+	for _, isolation := range []sql.IsolationLevel{sql.LevelReadUncommitted, sql.LevelReadCommitted, sql.LevelRepeatableRead, sql.LevelSnapshot, sql.LevelSerializable} {
+		if strings.ToLower(isolation.String()) == normalized {
+			return isolation, nil
+		}
+	}
+	return sql.LevelDefault, errors.Error("unknown isolation level " + level)
+}
+
+type txContextKey struct{}
+
+// activeTx marks the transaction of owner (the database handle) in a context
+type activeTx struct {
+	owner interface{}
+	tx    SQLTransactional
+}
+
+func contextWithTx(ctx context.Context, owner interface{}, tx SQLTransactional) context.Context {
+	return context.WithValue(ctx, txContextKey{}, activeTx{owner: owner, tx: tx})
+}
+
+func txFromContext(ctx context.Context, owner interface{}) SQLTransactional {
+	active, ok := ctx.Value(txContextKey{}).(activeTx)
+	if !ok || active.owner != owner {
+		return nil
+	}
+	return active.tx
+}
+
+// sqlxTx runs every statement with the context of the transaction so cancelling it aborts the running query
+type sqlxTx struct {
+	*sqlx.Tx
+	ctx        context.Context
+	savepoints int
+}
+
+func (tx *sqlxTx) Select(dest interface{}, query string, args ...interface{}) error {
+	return tx.Tx.SelectContext(tx.ctx, dest, query, args...)
+}
+
+func (tx *sqlxTx) Exec(query string, args ...any) (sql.Result, error) {
+	return tx.Tx.ExecContext(tx.ctx, query, args...)
+}
+
+func (tx *sqlxTx) Context() context.Context {
+	return tx.ctx
+}
+
+// sqlxDB runs every statement outside of a transaction with ctx
+type sqlxDB struct {
+	*sqlx.DB
+	ctx context.Context
+}
+
+func (db *sqlxDB) Select(dest interface{}, query string, args ...interface{}) error {
+	return db.DB.SelectContext(db.ctx, dest, query, args...)
+}
+
+func (db *sqlxDB) Exec(query string, args ...any) (sql.Result, error) {
+	return db.DB.ExecContext(db.ctx, query, args...)
+}
+
+// sqlxReader returns the transaction of db carried by ctx, or db with ctx
+func sqlxReader(ctx context.Context, db *sqlx.DB) SQLTransactional {
+	if tx := txFromContext(ctx, db); tx != nil {
+		return tx
+	}
+	return &sqlxDB{DB: db, ctx: ctx}
+}
+
+func WithSQLXTx(db *sqlx.DB, cb func(SQLTransactional) error) error {
+	return WithSQLXTxContext(context.Background(), db, cb)
+}
+
+// WithSQLXTxContext runs cb in a transaction that is committed when cb succeeds and rolled back when it fails or panics.
+// When ctx already carries a transaction of db, cb runs inside a savepoint of it instead, so a failing nested call
+// only discards its own changes. Serialization failures of the outermost transaction are retried.
+func WithSQLXTxContext(ctx context.Context, db *sqlx.DB, cb func(SQLTransactional) error) (err error) {
+	if tx, ok := txFromContext(ctx, db).(*sqlxTx); ok {
+		return withSavepoint(tx, cb)
+	}
+	for attempt := 0; ; attempt++ {
+		err = runSQLXTx(ctx, db, cb)
+		if err == nil || attempt >= DefaultTxOptions.MaxRetries || !isSerializationFailure(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt+1) * retryBackoff):
+		}
+	}
+}
+
+func runSQLXTx(ctx context.Context, db *sqlx.DB, cb func(SQLTransactional) error) (err error) {
+	opts := &sql.TxOptions{}
+	// sqlite transactions are always serializable and the driver takes no options
+	if db.DriverName() != SQLiteDriverName {
+		opts.Isolation = DefaultTxOptions.Isolation
+	}
+	rawTx, err := db.BeginTxx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	tx := &sqlxTx{Tx: rawTx}
+	tx.ctx = contextWithTx(ctx, db, tx)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			rawTx.Rollback()
+			panic(recovered)
+		}
+		if err != nil {
+			rawTx.Rollback()
+		}
+	}()
+	if err = cb(tx); err != nil {
+		return err
+	}
+	return rawTx.Commit()
+}
+
+func withSavepoint(tx *sqlxTx, cb func(SQLTransactional) error) (err error) {
+	tx.savepoints++
+	savepoint := fmt.Sprintf("sp_%d", tx.savepoints)
+	if _, err = tx.Exec("SAVEPOINT " + savepoint); err != nil {
+		return err
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tx.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+			panic(recovered)
+		}
+		if err != nil {
+			tx.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+		}
+	}()
+	if err = cb(tx); err != nil {
+		return err
+	}
+	_, err = tx.Exec("RELEASE SAVEPOINT " + savepoint)
+	return err
+}
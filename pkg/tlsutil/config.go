@@ -0,0 +1,56 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+)
+
+// NewServerConfig builds a server tls config whose key pair and client CA bundle follow rotations on disk.
+// Client certificates are only verified when clientCAFile is set.
+func NewServerConfig(certFile, keyFile, clientCAFile string, requireClientCert bool) (*tls.Config, error) {
+	keyPair, err := NewKeyPairReloader(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	base := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: keyPair.GetCertificate,
+	}
+	if clientCAFile == "" {
+		return base, nil
+	}
+	clientCAs, err := NewCAPoolReloader(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	base.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		base.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.ClientCAs = clientCAs.Pool()
+		return cfg, nil
+	}
+	return base, nil
+}
+
+// NewClientConfig builds a client tls config, the client key pair is optional and follows rotations on disk
+func NewClientConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if caFile != "" {
+		pool, err := loadCAPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if certFile != "" {
+		keyPair, err := NewKeyPairReloader(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = keyPair.GetClientCertificate
+	}
+	return cfg, nil
+}
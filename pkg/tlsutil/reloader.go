@@ -0,0 +1,150 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dlshle/gommon/errors"
+	"github.com/dlshle/gommon/logging"
+)
+
+// files are stat-ed at most this often during handshakes to pick up rotated certificates
+const reloadCheckInterval = 10 * time.Second
+
+type fileWatcher struct {
+	paths     []string
+	modTimes  []time.Time
+	lastCheck time.Time
+}
+
+func newFileWatcher(paths ...string) *fileWatcher {
+	return &fileWatcher{paths: paths, modTimes: make([]time.Time, len(paths), len(paths))}
+}
+
+// changed reports whether any file has a new modification time since the last commit and returns the
+// modification times to commit once the files are loaded
+func (w *fileWatcher) changed() ([]time.Time, bool) {
+	if time.Since(w.lastCheck) < reloadCheckInterval {
+		return nil, false
+	}
+	w.lastCheck = time.Now()
+	changed := false
+	modTimes := make([]time.Time, len(w.paths), len(w.paths))
+	copy(modTimes, w.modTimes)
+	for i, path := range w.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			// the file may be in the middle of a rotation, keep the current one
+			continue
+		}
+		if !info.ModTime().Equal(w.modTimes[i]) {
+			modTimes[i] = info.ModTime()
+			changed = true
+		}
+	}
+	return modTimes, changed
+}
+
+// commit records the modification times of files that were loaded, files that failed to load are checked
+// again on the next call of changed
+func (w *fileWatcher) commit(modTimes []time.Time) {
+	w.modTimes = modTimes
+}
+
+// KeyPairReloader serves a certificate/key pair and reloads it when the files change on disk
+type KeyPairReloader struct {
+	certFile string
+	keyFile  string
+	watcher  *fileWatcher
+	cert     *tls.Certificate
+	mutex    sync.Mutex
+}
+
+func NewKeyPairReloader(certFile, keyFile string) (*KeyPairReloader, error) {
+	r := &KeyPairReloader{certFile: certFile, keyFile: keyFile, watcher: newFileWatcher(certFile, keyFile)}
+	modTimes, _ := r.watcher.changed()
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	r.watcher.commit(modTimes)
+	r.cert = &cert
+	return r, nil
+}
+
+func (r *KeyPairReloader) Certificate() *tls.Certificate {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if modTimes, changed := r.watcher.changed(); changed {
+		cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			// e.g. the files were read in the middle of a write, retried after the next check interval
+			logging.GlobalLogger.Warnf(context.Background(), "failed to reload key pair %s due to %s", r.certFile, err.Error())
+		} else {
+			logging.GlobalLogger.Infof(context.Background(), "reloaded key pair %s", r.certFile)
+			r.watcher.commit(modTimes)
+			r.cert = &cert
+		}
+	}
+	return r.cert
+}
+
+func (r *KeyPairReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+func (r *KeyPairReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// CAPoolReloader serves a PEM CA bundle as a cert pool and reloads it when the file changes on disk
+type CAPoolReloader struct {
+	caFile  string
+	watcher *fileWatcher
+	pool    *x509.CertPool
+	mutex   sync.Mutex
+}
+
+func NewCAPoolReloader(caFile string) (*CAPoolReloader, error) {
+	r := &CAPoolReloader{caFile: caFile, watcher: newFileWatcher(caFile)}
+	modTimes, _ := r.watcher.changed()
+	pool, err := loadCAPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+	r.watcher.commit(modTimes)
+	r.pool = pool
+	return r, nil
+}
+
+func (r *CAPoolReloader) Pool() *x509.CertPool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if modTimes, changed := r.watcher.changed(); changed {
+		pool, err := loadCAPool(r.caFile)
+		if err != nil {
+			logging.GlobalLogger.Warnf(context.Background(), "failed to reload CA bundle %s due to %s", r.caFile, err.Error())
+		} else {
+			logging.GlobalLogger.Infof(context.Background(), "reloaded CA bundle %s", r.caFile)
+			r.watcher.commit(modTimes)
+			r.pool = pool
+		}
+	}
+	return r.pool
+}
+
+func loadCAPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.Error("no certificate found in " + caFile)
+	}
+	return pool, nil
+}
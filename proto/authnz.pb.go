@@ -127,6 +127,153 @@ func (Verdict) EnumDescriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{1}
 }
 
+type SoDRule_Kind int32
+
+const (
+	SoDRule_STATIC  SoDRule_Kind = 0
+	SoDRule_DYNAMIC SoDRule_Kind = 1
+)
+
+// Enum value maps for SoDRule_Kind.
+var (
+	SoDRule_Kind_name = map[int32]string{
+		0: "STATIC",
+		1: "DYNAMIC",
+	}
+	SoDRule_Kind_value = map[string]int32{
+		"STATIC":  0,
+		"DYNAMIC": 1,
+	}
+)
+
+func (x SoDRule_Kind) Enum() *SoDRule_Kind {
+	p := new(SoDRule_Kind)
+	*p = x
+	return p
+}
+
+func (x SoDRule_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SoDRule_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_authnz_proto_enumTypes[2].Descriptor()
+}
+
+func (SoDRule_Kind) Type() protoreflect.EnumType {
+	return &file_proto_authnz_proto_enumTypes[2]
+}
+
+func (x SoDRule_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SoDRule_Kind.Descriptor instead.
+func (SoDRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type AccessRequest_State int32
+
+const (
+	AccessRequest_STATE_UNKNOWN AccessRequest_State = 0
+	AccessRequest_PENDING       AccessRequest_State = 1
+	AccessRequest_APPROVED      AccessRequest_State = 2
+	AccessRequest_DENIED        AccessRequest_State = 3
+	AccessRequest_CANCELLED     AccessRequest_State = 4
+)
+
+// Enum value maps for AccessRequest_State.
+var (
+	AccessRequest_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "DENIED",
+		4: "CANCELLED",
+	}
+	AccessRequest_State_value = map[string]int32{
+		"STATE_UNKNOWN": 0,
+		"PENDING":       1,
+		"APPROVED":      2,
+		"DENIED":        3,
+		"CANCELLED":     4,
+	}
+)
+
+func (x AccessRequest_State) Enum() *AccessRequest_State {
+	p := new(AccessRequest_State)
+	*p = x
+	return p
+}
+
+func (x AccessRequest_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessRequest_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_authnz_proto_enumTypes[3].Descriptor()
+}
+
+func (AccessRequest_State) Type() protoreflect.EnumType {
+	return &file_proto_authnz_proto_enumTypes[3]
+}
+
+func (x AccessRequest_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessRequest_State.Descriptor instead.
+func (AccessRequest_State) EnumDescriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type RelationTree_Operation int32
+
+const (
+	RelationTree_LEAF  RelationTree_Operation = 0
+	RelationTree_UNION RelationTree_Operation = 1
+)
+
+// Enum value maps for RelationTree_Operation.
+var (
+	RelationTree_Operation_name = map[int32]string{
+		0: "LEAF",
+		1: "UNION",
+	}
+	RelationTree_Operation_value = map[string]int32{
+		"LEAF":  0,
+		"UNION": 1,
+	}
+)
+
+func (x RelationTree_Operation) Enum() *RelationTree_Operation {
+	p := new(RelationTree_Operation)
+	*p = x
+	return p
+}
+
+func (x RelationTree_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationTree_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_authnz_proto_enumTypes[4].Descriptor()
+}
+
+func (RelationTree_Operation) Type() protoreflect.EnumType {
+	return &file_proto_authnz_proto_enumTypes[4]
+}
+
+func (x RelationTree_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationTree_Operation.Descriptor instead.
+func (RelationTree_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{15, 0}
+}
+
 // each subject represents a user
 // each user holds multiple groups
 type Subject struct {
@@ -191,6 +338,14 @@ type Group struct {
 
 	Id         string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// set on every read, an update carrying a non-zero version fails with ABORTED when the group changed since
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// groups whose attributes this group inherits, later parents override earlier ones and the group's own attributes override all of them
+	ParentIds []string `protobuf:"bytes,4,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// roles granted to every subject contracted to this group or to one of its descendants
+	RoleIds []string `protobuf:"bytes,5,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	// subjects permitted by this policy approve access requests for the group, requests are rejected without one
+	ApprovalPolicyId string `protobuf:"bytes,6,opt,name=approval_policy_id,json=approvalPolicyId,proto3" json:"approval_policy_id,omitempty"`
 }
 
 func (x *Group) Reset() {
@@ -239,6 +394,34 @@ func (x *Group) GetAttributes() []*Attribute {
 	return nil
 }
 
+func (x *Group) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Group) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *Group) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *Group) GetApprovalPolicyId() string {
+	if x != nil {
+		return x.ApprovalPolicyId
+	}
+	return ""
+}
+
 type Attribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -302,6 +485,11 @@ type Contract struct {
 	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 	GroupId   string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// unix seconds bounding when the subject is in the group, 0 leaves the bound open
+	NotBefore int64 `protobuf:"varint,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// set on emergency contracts granted by breakGlass
+	BreakGlass *BreakGlass `protobuf:"bytes,6,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 }
 
 func (x *Contract) Reset() {
@@ -357,17 +545,39 @@ func (x *Contract) GetGroupId() string {
 	return ""
 }
 
-type Policy struct {
+func (x *Contract) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *Contract) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Contract) GetBreakGlass() *BreakGlass {
+	if x != nil {
+		return x.BreakGlass
+	}
+	return nil
+}
+
+type BreakGlass struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Condition *PolicyCondition `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	// the subject that granted the contract, empty when authentication is disabled
+	GrantedBy string `protobuf:"bytes,2,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
 }
 
-func (x *Policy) Reset() {
-	*x = Policy{}
+func (x *BreakGlass) Reset() {
+	*x = BreakGlass{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,13 +585,13 @@ func (x *Policy) Reset() {
 	}
 }
 
-func (x *Policy) String() string {
+func (x *BreakGlass) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Policy) ProtoMessage() {}
+func (*BreakGlass) ProtoMessage() {}
 
-func (x *Policy) ProtoReflect() protoreflect.Message {
+func (x *BreakGlass) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,44 +603,40 @@ func (x *Policy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
-func (*Policy) Descriptor() ([]byte, []int) {
+// Deprecated: Use BreakGlass.ProtoReflect.Descriptor instead.
+func (*BreakGlass) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Policy) GetId() string {
+func (x *BreakGlass) GetJustification() string {
 	if x != nil {
-		return x.Id
+		return x.Justification
 	}
 	return ""
 }
 
-func (x *Policy) GetCondition() *PolicyCondition {
+func (x *BreakGlass) GetGrantedBy() string {
 	if x != nil {
-		return x.Condition
+		return x.GrantedBy
 	}
-	return nil
+	return ""
 }
 
-type PolicyCondition struct {
+type Policy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Condition:
-	//	*PolicyCondition_HasAttribute
-	//	*PolicyCondition_EvaluateAttribute
-	//	*PolicyCondition_ContextInGroupAttributes
-	//	*PolicyCondition_ContextInLiteralSet
-	//	*PolicyCondition_AttributeInLiteralSet
-	//	*PolicyCondition_Negation
-	//	*PolicyCondition_Or
-	//	*PolicyCondition_And
-	Condition isPolicyCondition_Condition `protobuf_oneof:"condition"`
+	Id        string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Condition *PolicyCondition `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	// set on every read, an update carrying a non-zero version fails with ABORTED when the policy changed since
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// policies with a target are selected by authorize requests that carry an action instead of a policy id
+	Target *PolicyTarget `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *PolicyCondition) Reset() {
-	*x = PolicyCondition{}
+func (x *Policy) Reset() {
+	*x = Policy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,13 +644,13 @@ func (x *PolicyCondition) Reset() {
 	}
 }
 
-func (x *PolicyCondition) String() string {
+func (x *Policy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyCondition) ProtoMessage() {}
+func (*Policy) ProtoMessage() {}
 
-func (x *PolicyCondition) ProtoReflect() protoreflect.Message {
+func (x *Policy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,152 +662,190 @@ func (x *PolicyCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyCondition.ProtoReflect.Descriptor instead.
-func (*PolicyCondition) Descriptor() ([]byte, []int) {
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{5}
 }
 
-func (m *PolicyCondition) GetCondition() isPolicyCondition_Condition {
-	if m != nil {
-		return m.Condition
+func (x *Policy) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *PolicyCondition) GetHasAttribute() *HasAttributesCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_HasAttribute); ok {
-		return x.HasAttribute
+func (x *Policy) GetCondition() *PolicyCondition {
+	if x != nil {
+		return x.Condition
 	}
 	return nil
 }
 
-func (x *PolicyCondition) GetEvaluateAttribute() *EvaluateOPCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_EvaluateAttribute); ok {
-		return x.EvaluateAttribute
+func (x *Policy) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *PolicyCondition) GetContextInGroupAttributes() *ContextInGroupAttributesCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_ContextInGroupAttributes); ok {
-		return x.ContextInGroupAttributes
+func (x *Policy) GetTarget() *PolicyTarget {
+	if x != nil {
+		return x.Target
 	}
 	return nil
 }
 
-func (x *PolicyCondition) GetContextInLiteralSet() *ContextInLiteralSetCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_ContextInLiteralSet); ok {
-		return x.ContextInLiteralSet
-	}
-	return nil
+// PolicyTarget matches the action and resource type of an authorize request, an empty list matches any value
+type PolicyTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions       []string `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	ResourceTypes []string `protobuf:"bytes,2,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
 }
 
-func (x *PolicyCondition) GetAttributeInLiteralSet() *ContextInGroupAttributesInLiteralSetCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_AttributeInLiteralSet); ok {
-		return x.AttributeInLiteralSet
+func (x *PolicyTarget) Reset() {
+	*x = PolicyTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PolicyCondition) GetNegation() *NegationCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_Negation); ok {
-		return x.Negation
+func (x *PolicyTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyTarget) ProtoMessage() {}
+
+func (x *PolicyTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PolicyCondition) GetOr() *OrCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_Or); ok {
-		return x.Or
+// Deprecated: Use PolicyTarget.ProtoReflect.Descriptor instead.
+func (*PolicyTarget) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyTarget) GetActions() []string {
+	if x != nil {
+		return x.Actions
 	}
 	return nil
 }
 
-func (x *PolicyCondition) GetAnd() *AndCondition {
-	if x, ok := x.GetCondition().(*PolicyCondition_And); ok {
-		return x.And
+func (x *PolicyTarget) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
 	}
 	return nil
 }
 
-type isPolicyCondition_Condition interface {
-	isPolicyCondition_Condition()
-}
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type PolicyCondition_HasAttribute struct {
-	HasAttribute *HasAttributesCondition `protobuf:"bytes,1,opt,name=has_attribute,json=hasAttribute,proto3,oneof"`
+	Type       string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id         string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes []*Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
-type PolicyCondition_EvaluateAttribute struct {
-	EvaluateAttribute *EvaluateOPCondition `protobuf:"bytes,2,opt,name=evaluate_attribute,json=evaluateAttribute,proto3,oneof"`
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type PolicyCondition_ContextInGroupAttributes struct {
-	ContextInGroupAttributes *ContextInGroupAttributesCondition `protobuf:"bytes,3,opt,name=context_in_group_attributes,json=contextInGroupAttributes,proto3,oneof"`
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type PolicyCondition_ContextInLiteralSet struct {
-	ContextInLiteralSet *ContextInLiteralSetCondition `protobuf:"bytes,4,opt,name=context_in_literal_set,json=contextInLiteralSet,proto3,oneof"`
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type PolicyCondition_AttributeInLiteralSet struct {
-	AttributeInLiteralSet *ContextInGroupAttributesInLiteralSetCondition `protobuf:"bytes,5,opt,name=attribute_in_literal_set,json=attributeInLiteralSet,proto3,oneof"`
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{7}
 }
 
-type PolicyCondition_Negation struct {
-	Negation *NegationCondition `protobuf:"bytes,6,opt,name=negation,proto3,oneof"`
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-type PolicyCondition_Or struct {
-	Or *OrCondition `protobuf:"bytes,7,opt,name=or,proto3,oneof"`
+func (x *Resource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-type PolicyCondition_And struct {
-	And *AndCondition `protobuf:"bytes,8,opt,name=and,proto3,oneof"`
+func (x *Resource) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
 }
 
-func (*PolicyCondition_HasAttribute) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_EvaluateAttribute) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_ContextInGroupAttributes) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_ContextInLiteralSet) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_AttributeInLiteralSet) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_Negation) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_Or) isPolicyCondition_Condition() {}
-
-func (*PolicyCondition_And) isPolicyCondition_Condition() {}
-
-// check if the request(group)
-type HasAttributesCondition struct {
+// Role is a named bundle of permissions, permissions are free form strings such as "documents:read" where
+// a trailing "*" segment grants every permission under its prefix
+type Role struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttributeKey []string `protobuf:"bytes,1,rep,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// set on every read, an update carrying a non-zero version fails with ABORTED when the role changed since
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *HasAttributesCondition) Reset() {
-	*x = HasAttributesCondition{}
+func (x *Role) Reset() {
+	*x = Role{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[6]
+		mi := &file_proto_authnz_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HasAttributesCondition) String() string {
+func (x *Role) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HasAttributesCondition) ProtoMessage() {}
+func (*Role) ProtoMessage() {}
 
-func (x *HasAttributesCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[6]
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,45 +856,80 @@ func (x *HasAttributesCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HasAttributesCondition.ProtoReflect.Descriptor instead.
-func (*HasAttributesCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *HasAttributesCondition) GetAttributeKey() []string {
+func (x *Role) GetId() string {
 	if x != nil {
-		return x.AttributeKey
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type EvaluateOPCondition struct {
+func (x *Role) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SoDRule keeps a subject out of more than one of its groups. Static rules are checked when contracts are added,
+// dynamic rules let a subject hold contracts with several of the groups as long as only one of them is in effect,
+// both are checked on every authorization.
+type SoDRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttributeKey string    `protobuf:"bytes,1,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
-	Op           Operation `protobuf:"varint,2,opt,name=op,proto3,enum=com.github.dlshle.authnz.Operation" json:"op,omitempty"`
-	Value        string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// at least two, a group matching several of them conflicts with itself
+	Groups []*SoDGroup  `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Kind   SoDRule_Kind `protobuf:"varint,4,opt,name=kind,proto3,enum=com.github.dlshle.authnz.SoDRule_Kind" json:"kind,omitempty"`
+	// set on every read, an update carrying a non-zero version fails with ABORTED when the rule changed since
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *EvaluateOPCondition) Reset() {
-	*x = EvaluateOPCondition{}
+func (x *SoDRule) Reset() {
+	*x = SoDRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[7]
+		mi := &file_proto_authnz_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EvaluateOPCondition) String() string {
+func (x *SoDRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluateOPCondition) ProtoMessage() {}
+func (*SoDRule) ProtoMessage() {}
 
-func (x *EvaluateOPCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[7]
+func (x *SoDRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,58 +940,73 @@ func (x *EvaluateOPCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluateOPCondition.ProtoReflect.Descriptor instead.
-func (*EvaluateOPCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SoDRule.ProtoReflect.Descriptor instead.
+func (*SoDRule) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EvaluateOPCondition) GetAttributeKey() string {
+func (x *SoDRule) GetId() string {
 	if x != nil {
-		return x.AttributeKey
+		return x.Id
 	}
 	return ""
 }
 
-func (x *EvaluateOPCondition) GetOp() Operation {
+func (x *SoDRule) GetName() string {
 	if x != nil {
-		return x.Op
+		return x.Name
 	}
-	return Operation_EQ
+	return ""
 }
 
-func (x *EvaluateOPCondition) GetValue() string {
+func (x *SoDRule) GetGroups() []*SoDGroup {
 	if x != nil {
-		return x.Value
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type ContextInGroupAttributesCondition struct {
+func (x *SoDRule) GetKind() SoDRule_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SoDRule_STATIC
+}
+
+func (x *SoDRule) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SoDGroup selects a group by id, or every group carrying the attribute, both including the groups descending from it
+type SoDGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContextKey        string   `protobuf:"bytes,1,opt,name=context_key,json=contextKey,proto3" json:"context_key,omitempty"`
-	GroupAttributeKey []string `protobuf:"bytes,2,rep,name=group_attribute_key,json=groupAttributeKey,proto3" json:"group_attribute_key,omitempty"`
+	GroupId   string     `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Attribute *Attribute `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
 }
 
-func (x *ContextInGroupAttributesCondition) Reset() {
-	*x = ContextInGroupAttributesCondition{}
+func (x *SoDGroup) Reset() {
+	*x = SoDGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[8]
+		mi := &file_proto_authnz_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContextInGroupAttributesCondition) String() string {
+func (x *SoDGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContextInGroupAttributesCondition) ProtoMessage() {}
+func (*SoDGroup) ProtoMessage() {}
 
-func (x *ContextInGroupAttributesCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[8]
+func (x *SoDGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,51 +1017,69 @@ func (x *ContextInGroupAttributesCondition) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContextInGroupAttributesCondition.ProtoReflect.Descriptor instead.
-func (*ContextInGroupAttributesCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SoDGroup.ProtoReflect.Descriptor instead.
+func (*SoDGroup) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ContextInGroupAttributesCondition) GetContextKey() string {
+func (x *SoDGroup) GetGroupId() string {
 	if x != nil {
-		return x.ContextKey
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *ContextInGroupAttributesCondition) GetGroupAttributeKey() []string {
+func (x *SoDGroup) GetAttribute() *Attribute {
 	if x != nil {
-		return x.GroupAttributeKey
+		return x.Attribute
 	}
 	return nil
 }
 
-type ContextInLiteralSetCondition struct {
+// AccessRequest asks for a contract between the subject and the group, approving it creates the contract
+type AccessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContextKey string   `protobuf:"bytes,1,opt,name=context_key,json=contextKey,proto3" json:"context_key,omitempty"`
-	Literal    []string `protobuf:"bytes,2,rep,name=literal,proto3" json:"literal,omitempty"`
-}
-
-func (x *ContextInLiteralSetCondition) Reset() {
-	*x = ContextInLiteralSetCondition{}
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	GroupId   string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// length of the membership granted on approval in seconds, 0 grants a permanent one
+	DurationSeconds int64               `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	State           AccessRequest_State `protobuf:"varint,6,opt,name=state,proto3,enum=com.github.dlshle.authnz.AccessRequest_State" json:"state,omitempty"`
+	// approval policy of the group when the request was made, its subjects are the request's approvers
+	ApprovalPolicyId string `protobuf:"bytes,7,opt,name=approval_policy_id,json=approvalPolicyId,proto3" json:"approval_policy_id,omitempty"`
+	// the approver that decided the request and the reason given
+	DecidedBy      string `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecisionReason string `protobuf:"bytes,9,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	// unix seconds
+	CreatedAt int64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt int64 `protobuf:"varint,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// contract created on approval
+	ContractId string `protobuf:"bytes,12,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// set on every read
+	Version int64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[9]
+		mi := &file_proto_authnz_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContextInLiteralSetCondition) String() string {
+func (x *AccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContextInLiteralSetCondition) ProtoMessage() {}
+func (*AccessRequest) ProtoMessage() {}
 
-func (x *ContextInLiteralSetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[9]
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,106 +1090,128 @@ func (x *ContextInLiteralSetCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContextInLiteralSetCondition.ProtoReflect.Descriptor instead.
-func (*ContextInLiteralSetCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ContextInLiteralSetCondition) GetContextKey() string {
+func (x *AccessRequest) GetId() string {
 	if x != nil {
-		return x.ContextKey
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ContextInLiteralSetCondition) GetLiteral() []string {
+func (x *AccessRequest) GetSubjectId() string {
 	if x != nil {
-		return x.Literal
+		return x.SubjectId
 	}
-	return nil
+	return ""
 }
 
-// if certain attribute is within the string literal
-type ContextInGroupAttributesInLiteralSetCondition struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AccessRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
 
-	GroupAttributeKey string   `protobuf:"bytes,1,opt,name=group_attribute_key,json=groupAttributeKey,proto3" json:"group_attribute_key,omitempty"`
-	Literal           []string `protobuf:"bytes,2,rep,name=literal,proto3" json:"literal,omitempty"`
+func (x *AccessRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *ContextInGroupAttributesInLiteralSetCondition) Reset() {
-	*x = ContextInGroupAttributesInLiteralSetCondition{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
 	}
+	return 0
 }
 
-func (x *ContextInGroupAttributesInLiteralSetCondition) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessRequest) GetState() AccessRequest_State {
+	if x != nil {
+		return x.State
+	}
+	return AccessRequest_STATE_UNKNOWN
 }
 
-func (*ContextInGroupAttributesInLiteralSetCondition) ProtoMessage() {}
+func (x *AccessRequest) GetApprovalPolicyId() string {
+	if x != nil {
+		return x.ApprovalPolicyId
+	}
+	return ""
+}
 
-func (x *ContextInGroupAttributesInLiteralSetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ContextInGroupAttributesInLiteralSetCondition.ProtoReflect.Descriptor instead.
-func (*ContextInGroupAttributesInLiteralSetCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{10}
+func (x *AccessRequest) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
 }
 
-func (x *ContextInGroupAttributesInLiteralSetCondition) GetGroupAttributeKey() string {
+func (x *AccessRequest) GetCreatedAt() int64 {
 	if x != nil {
-		return x.GroupAttributeKey
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetContractId() string {
+	if x != nil {
+		return x.ContractId
 	}
 	return ""
 }
 
-func (x *ContextInGroupAttributesInLiteralSetCondition) GetLiteral() []string {
+func (x *AccessRequest) GetVersion() int64 {
 	if x != nil {
-		return x.Literal
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type NegationCondition struct {
+type RelationObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition *PolicyCondition `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *NegationCondition) Reset() {
-	*x = NegationCondition{}
+func (x *RelationObject) Reset() {
+	*x = RelationObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[11]
+		mi := &file_proto_authnz_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NegationCondition) String() string {
+func (x *RelationObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NegationCondition) ProtoMessage() {}
+func (*RelationObject) ProtoMessage() {}
 
-func (x *NegationCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[11]
+func (x *RelationObject) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,43 +1222,53 @@ func (x *NegationCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NegationCondition.ProtoReflect.Descriptor instead.
-func (*NegationCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RelationObject.ProtoReflect.Descriptor instead.
+func (*RelationObject) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NegationCondition) GetCondition() *PolicyCondition {
+func (x *RelationObject) GetNamespace() string {
 	if x != nil {
-		return x.Condition
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type OrCondition struct {
+func (x *RelationObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SubjectSet is every subject having relation on object, e.g. the members of a team. An empty relation
+// refers to the object itself, which is what tuple_to_userset rewrites follow, e.g. the parent folder of a document.
+type SubjectSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition []*PolicyCondition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
+	Object   *RelationObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Relation string          `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
 }
 
-func (x *OrCondition) Reset() {
-	*x = OrCondition{}
+func (x *SubjectSet) Reset() {
+	*x = SubjectSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[12]
+		mi := &file_proto_authnz_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrCondition) String() string {
+func (x *SubjectSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrCondition) ProtoMessage() {}
+func (*SubjectSet) ProtoMessage() {}
 
-func (x *OrCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[12]
+func (x *SubjectSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,76 +1279,39 @@ func (x *OrCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrCondition.ProtoReflect.Descriptor instead.
-func (*OrCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SubjectSet.ProtoReflect.Descriptor instead.
+func (*SubjectSet) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *OrCondition) GetCondition() []*PolicyCondition {
+func (x *SubjectSet) GetObject() *RelationObject {
 	if x != nil {
-		return x.Condition
+		return x.Object
 	}
 	return nil
 }
 
-type AndCondition struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Condition []*PolicyCondition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
-}
-
-func (x *AndCondition) Reset() {
-	*x = AndCondition{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AndCondition) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AndCondition) ProtoMessage() {}
-
-func (x *AndCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AndCondition.ProtoReflect.Descriptor instead.
-func (*AndCondition) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *AndCondition) GetCondition() []*PolicyCondition {
+func (x *SubjectSet) GetRelation() string {
 	if x != nil {
-		return x.Condition
+		return x.Relation
 	}
-	return nil
+	return ""
 }
 
-type ContextProperty struct {
+// RelationTuple reads object#relation@subject, the subject is either a subject id or a subject set
+type RelationTuple struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Object     *RelationObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Relation   string          `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	SubjectId  string          `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SubjectSet *SubjectSet     `protobuf:"bytes,4,opt,name=subject_set,json=subjectSet,proto3" json:"subject_set,omitempty"`
 }
 
-func (x *ContextProperty) Reset() {
-	*x = ContextProperty{}
+func (x *RelationTuple) Reset() {
+	*x = RelationTuple{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,13 +1319,13 @@ func (x *ContextProperty) Reset() {
 	}
 }
 
-func (x *ContextProperty) String() string {
+func (x *RelationTuple) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContextProperty) ProtoMessage() {}
+func (*RelationTuple) ProtoMessage() {}
 
-func (x *ContextProperty) ProtoReflect() protoreflect.Message {
+func (x *RelationTuple) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,38 +1337,56 @@ func (x *ContextProperty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContextProperty.ProtoReflect.Descriptor instead.
-func (*ContextProperty) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelationTuple.ProtoReflect.Descriptor instead.
+func (*RelationTuple) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ContextProperty) GetKey() string {
+func (x *RelationTuple) GetObject() *RelationObject {
 	if x != nil {
-		return x.Key
+		return x.Object
+	}
+	return nil
+}
+
+func (x *RelationTuple) GetRelation() string {
+	if x != nil {
+		return x.Relation
 	}
 	return ""
 }
 
-func (x *ContextProperty) GetValue() string {
+func (x *RelationTuple) GetSubjectId() string {
 	if x != nil {
-		return x.Value
+		return x.SubjectId
 	}
 	return ""
 }
 
-type AuthorizeRequest struct {
+func (x *RelationTuple) GetSubjectSet() *SubjectSet {
+	if x != nil {
+		return x.SubjectSet
+	}
+	return nil
+}
+
+// RelationTree is the expansion of a userset: leaves list the subjects and subject sets of direct tuples,
+// unions combine the usersets a relation is rewritten to
+type RelationTree struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubjectId       string             `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"` // Caller will be trusted that this is authentic.
-	PolicyId        string             `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
-	ContextProperty []*ContextProperty `protobuf:"bytes,3,rep,name=context_property,json=contextProperty,proto3" json:"context_property,omitempty"`
-	Verbose         bool               `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	Operation RelationTree_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=com.github.dlshle.authnz.RelationTree_Operation" json:"operation,omitempty"`
+	// the object#relation this node expands
+	Userset     *SubjectSet     `protobuf:"bytes,2,opt,name=userset,proto3" json:"userset,omitempty"`
+	Children    []*RelationTree `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	SubjectIds  []string        `protobuf:"bytes,4,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+	SubjectSets []*SubjectSet   `protobuf:"bytes,5,rep,name=subject_sets,json=subjectSets,proto3" json:"subject_sets,omitempty"`
 }
 
-func (x *AuthorizeRequest) Reset() {
-	*x = AuthorizeRequest{}
+func (x *RelationTree) Reset() {
+	*x = RelationTree{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,13 +1394,13 @@ func (x *AuthorizeRequest) Reset() {
 	}
 }
 
-func (x *AuthorizeRequest) String() string {
+func (x *RelationTree) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizeRequest) ProtoMessage() {}
+func (*RelationTree) ProtoMessage() {}
 
-func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
+func (x *RelationTree) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,49 +1412,68 @@ func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelationTree.ProtoReflect.Descriptor instead.
+func (*RelationTree) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AuthorizeRequest) GetSubjectId() string {
+func (x *RelationTree) GetOperation() RelationTree_Operation {
 	if x != nil {
-		return x.SubjectId
+		return x.Operation
 	}
-	return ""
+	return RelationTree_LEAF
 }
 
-func (x *AuthorizeRequest) GetPolicyId() string {
+func (x *RelationTree) GetUserset() *SubjectSet {
 	if x != nil {
-		return x.PolicyId
+		return x.Userset
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthorizeRequest) GetContextProperty() []*ContextProperty {
+func (x *RelationTree) GetChildren() []*RelationTree {
 	if x != nil {
-		return x.ContextProperty
+		return x.Children
 	}
 	return nil
 }
 
-func (x *AuthorizeRequest) GetVerbose() bool {
+func (x *RelationTree) GetSubjectIds() []string {
 	if x != nil {
-		return x.Verbose
+		return x.SubjectIds
 	}
-	return false
+	return nil
 }
 
-type AuthorizeResponse struct {
+func (x *RelationTree) GetSubjectSets() []*SubjectSet {
+	if x != nil {
+		return x.SubjectSets
+	}
+	return nil
+}
+
+type PolicyCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Verdict Verdict `protobuf:"varint,1,opt,name=verdict,proto3,enum=com.github.dlshle.authnz.Verdict" json:"verdict,omitempty"`
+	// Types that are assignable to Condition:
+	//	*PolicyCondition_HasAttribute
+	//	*PolicyCondition_EvaluateAttribute
+	//	*PolicyCondition_ContextInGroupAttributes
+	//	*PolicyCondition_ContextInLiteralSet
+	//	*PolicyCondition_AttributeInLiteralSet
+	//	*PolicyCondition_Negation
+	//	*PolicyCondition_Or
+	//	*PolicyCondition_And
+	//	*PolicyCondition_MfaAuthenticated
+	//	*PolicyCondition_ResourceAttribute
+	//	*PolicyCondition_ResourceMatchesGroupAttribute
+	Condition isPolicyCondition_Condition `protobuf_oneof:"condition"`
 }
 
-func (x *AuthorizeResponse) Reset() {
-	*x = AuthorizeResponse{}
+func (x *PolicyCondition) Reset() {
+	*x = PolicyCondition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,13 +1481,13 @@ func (x *AuthorizeResponse) Reset() {
 	}
 }
 
-func (x *AuthorizeResponse) String() string {
+func (x *PolicyCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizeResponse) ProtoMessage() {}
+func (*PolicyCondition) ProtoMessage() {}
 
-func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
+func (x *PolicyCondition) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,147 +1499,191 @@ func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PolicyCondition.ProtoReflect.Descriptor instead.
+func (*PolicyCondition) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AuthorizeResponse) GetVerdict() Verdict {
-	if x != nil {
-		return x.Verdict
+func (m *PolicyCondition) GetCondition() isPolicyCondition_Condition {
+	if m != nil {
+		return m.Condition
 	}
-	return Verdict_UNKNOWN
+	return nil
 }
 
-type AuthContext struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// the set of properties used for authorization
-	ContextProperty []*ContextProperty `protobuf:"bytes,1,rep,name=context_property,json=contextProperty,proto3" json:"context_property,omitempty"`
-	// the subject id that will be authorized
-	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+func (x *PolicyCondition) GetHasAttribute() *HasAttributesCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_HasAttribute); ok {
+		return x.HasAttribute
+	}
+	return nil
 }
 
-func (x *AuthContext) Reset() {
-	*x = AuthContext{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PolicyCondition) GetEvaluateAttribute() *EvaluateOPCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_EvaluateAttribute); ok {
+		return x.EvaluateAttribute
 	}
+	return nil
 }
 
-func (x *AuthContext) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PolicyCondition) GetContextInGroupAttributes() *ContextInGroupAttributesCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_ContextInGroupAttributes); ok {
+		return x.ContextInGroupAttributes
+	}
+	return nil
 }
 
-func (*AuthContext) ProtoMessage() {}
-
-func (x *AuthContext) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PolicyCondition) GetContextInLiteralSet() *ContextInLiteralSetCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_ContextInLiteralSet); ok {
+		return x.ContextInLiteralSet
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AuthContext.ProtoReflect.Descriptor instead.
-func (*AuthContext) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{17}
+func (x *PolicyCondition) GetAttributeInLiteralSet() *ContextInGroupAttributesInLiteralSetCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_AttributeInLiteralSet); ok {
+		return x.AttributeInLiteralSet
+	}
+	return nil
 }
 
-func (x *AuthContext) GetContextProperty() []*ContextProperty {
-	if x != nil {
-		return x.ContextProperty
+func (x *PolicyCondition) GetNegation() *NegationCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_Negation); ok {
+		return x.Negation
 	}
 	return nil
 }
 
-func (x *AuthContext) GetSubjectId() string {
-	if x != nil {
-		return x.SubjectId
+func (x *PolicyCondition) GetOr() *OrCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_Or); ok {
+		return x.Or
 	}
-	return ""
+	return nil
 }
 
-type AddSubjectRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *PolicyCondition) GetAnd() *AndCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_And); ok {
+		return x.And
+	}
+	return nil
 }
 
-func (x *AddSubjectRequest) Reset() {
-	*x = AddSubjectRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PolicyCondition) GetMfaAuthenticated() *MFAAuthenticatedCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_MfaAuthenticated); ok {
+		return x.MfaAuthenticated
 	}
+	return nil
 }
 
-func (x *AddSubjectRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PolicyCondition) GetResourceAttribute() *ResourceAttributeCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_ResourceAttribute); ok {
+		return x.ResourceAttribute
+	}
+	return nil
 }
 
-func (*AddSubjectRequest) ProtoMessage() {}
-
-func (x *AddSubjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PolicyCondition) GetResourceMatchesGroupAttribute() *ResourceMatchesGroupAttributeCondition {
+	if x, ok := x.GetCondition().(*PolicyCondition_ResourceMatchesGroupAttribute); ok {
+		return x.ResourceMatchesGroupAttribute
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AddSubjectRequest.ProtoReflect.Descriptor instead.
-func (*AddSubjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{18}
+type isPolicyCondition_Condition interface {
+	isPolicyCondition_Condition()
 }
 
-func (x *AddSubjectRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type PolicyCondition_HasAttribute struct {
+	HasAttribute *HasAttributesCondition `protobuf:"bytes,1,opt,name=has_attribute,json=hasAttribute,proto3,oneof"`
 }
 
-type AddSubjectResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PolicyCondition_EvaluateAttribute struct {
+	EvaluateAttribute *EvaluateOPCondition `protobuf:"bytes,2,opt,name=evaluate_attribute,json=evaluateAttribute,proto3,oneof"`
+}
 
-	Subject *Subject `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+type PolicyCondition_ContextInGroupAttributes struct {
+	ContextInGroupAttributes *ContextInGroupAttributesCondition `protobuf:"bytes,3,opt,name=context_in_group_attributes,json=contextInGroupAttributes,proto3,oneof"`
 }
 
-func (x *AddSubjectResponse) Reset() {
-	*x = AddSubjectResponse{}
+type PolicyCondition_ContextInLiteralSet struct {
+	ContextInLiteralSet *ContextInLiteralSetCondition `protobuf:"bytes,4,opt,name=context_in_literal_set,json=contextInLiteralSet,proto3,oneof"`
+}
+
+type PolicyCondition_AttributeInLiteralSet struct {
+	AttributeInLiteralSet *ContextInGroupAttributesInLiteralSetCondition `protobuf:"bytes,5,opt,name=attribute_in_literal_set,json=attributeInLiteralSet,proto3,oneof"`
+}
+
+type PolicyCondition_Negation struct {
+	Negation *NegationCondition `protobuf:"bytes,6,opt,name=negation,proto3,oneof"`
+}
+
+type PolicyCondition_Or struct {
+	Or *OrCondition `protobuf:"bytes,7,opt,name=or,proto3,oneof"`
+}
+
+type PolicyCondition_And struct {
+	And *AndCondition `protobuf:"bytes,8,opt,name=and,proto3,oneof"`
+}
+
+type PolicyCondition_MfaAuthenticated struct {
+	MfaAuthenticated *MFAAuthenticatedCondition `protobuf:"bytes,9,opt,name=mfa_authenticated,json=mfaAuthenticated,proto3,oneof"`
+}
+
+type PolicyCondition_ResourceAttribute struct {
+	ResourceAttribute *ResourceAttributeCondition `protobuf:"bytes,10,opt,name=resource_attribute,json=resourceAttribute,proto3,oneof"`
+}
+
+type PolicyCondition_ResourceMatchesGroupAttribute struct {
+	ResourceMatchesGroupAttribute *ResourceMatchesGroupAttributeCondition `protobuf:"bytes,11,opt,name=resource_matches_group_attribute,json=resourceMatchesGroupAttribute,proto3,oneof"`
+}
+
+func (*PolicyCondition_HasAttribute) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_EvaluateAttribute) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_ContextInGroupAttributes) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_ContextInLiteralSet) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_AttributeInLiteralSet) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_Negation) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_Or) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_And) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_MfaAuthenticated) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_ResourceAttribute) isPolicyCondition_Condition() {}
+
+func (*PolicyCondition_ResourceMatchesGroupAttribute) isPolicyCondition_Condition() {}
+
+// check if the request(group)
+type HasAttributesCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeKey []string `protobuf:"bytes,1,rep,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+}
+
+func (x *HasAttributesCondition) Reset() {
+	*x = HasAttributesCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[19]
+		mi := &file_proto_authnz_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSubjectResponse) String() string {
+func (x *HasAttributesCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubjectResponse) ProtoMessage() {}
+func (*HasAttributesCondition) ProtoMessage() {}
 
-func (x *AddSubjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[19]
+func (x *HasAttributesCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,43 +1694,45 @@ func (x *AddSubjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubjectResponse.ProtoReflect.Descriptor instead.
-func (*AddSubjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use HasAttributesCondition.ProtoReflect.Descriptor instead.
+func (*HasAttributesCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AddSubjectResponse) GetSubject() *Subject {
+func (x *HasAttributesCondition) GetAttributeKey() []string {
 	if x != nil {
-		return x.Subject
+		return x.AttributeKey
 	}
 	return nil
 }
 
-type SubjectIDRequest struct {
+type EvaluateOPCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	AttributeKey string    `protobuf:"bytes,1,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	Op           Operation `protobuf:"varint,2,opt,name=op,proto3,enum=com.github.dlshle.authnz.Operation" json:"op,omitempty"`
+	Value        string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *SubjectIDRequest) Reset() {
-	*x = SubjectIDRequest{}
+func (x *EvaluateOPCondition) Reset() {
+	*x = EvaluateOPCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[20]
+		mi := &file_proto_authnz_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubjectIDRequest) String() string {
+func (x *EvaluateOPCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubjectIDRequest) ProtoMessage() {}
+func (*EvaluateOPCondition) ProtoMessage() {}
 
-func (x *SubjectIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[20]
+func (x *EvaluateOPCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,43 +1743,58 @@ func (x *SubjectIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubjectIDRequest.ProtoReflect.Descriptor instead.
-func (*SubjectIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use EvaluateOPCondition.ProtoReflect.Descriptor instead.
+func (*EvaluateOPCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SubjectIDRequest) GetSubjectId() string {
+func (x *EvaluateOPCondition) GetAttributeKey() string {
 	if x != nil {
-		return x.SubjectId
+		return x.AttributeKey
 	}
 	return ""
 }
 
-type SubjectsByUserIDRequest struct {
+func (x *EvaluateOPCondition) GetOp() Operation {
+	if x != nil {
+		return x.Op
+	}
+	return Operation_EQ
+}
+
+func (x *EvaluateOPCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ContextInGroupAttributesCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContextKey        string   `protobuf:"bytes,1,opt,name=context_key,json=contextKey,proto3" json:"context_key,omitempty"`
+	GroupAttributeKey []string `protobuf:"bytes,2,rep,name=group_attribute_key,json=groupAttributeKey,proto3" json:"group_attribute_key,omitempty"`
 }
 
-func (x *SubjectsByUserIDRequest) Reset() {
-	*x = SubjectsByUserIDRequest{}
+func (x *ContextInGroupAttributesCondition) Reset() {
+	*x = ContextInGroupAttributesCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[21]
+		mi := &file_proto_authnz_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubjectsByUserIDRequest) String() string {
+func (x *ContextInGroupAttributesCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubjectsByUserIDRequest) ProtoMessage() {}
+func (*ContextInGroupAttributesCondition) ProtoMessage() {}
 
-func (x *SubjectsByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[21]
+func (x *ContextInGroupAttributesCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,43 +1805,51 @@ func (x *SubjectsByUserIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubjectsByUserIDRequest.ProtoReflect.Descriptor instead.
-func (*SubjectsByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ContextInGroupAttributesCondition.ProtoReflect.Descriptor instead.
+func (*ContextInGroupAttributesCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SubjectsByUserIDRequest) GetUserId() string {
+func (x *ContextInGroupAttributesCondition) GetContextKey() string {
 	if x != nil {
-		return x.UserId
+		return x.ContextKey
 	}
 	return ""
 }
 
-type SubjectsByUserIDResponse struct {
+func (x *ContextInGroupAttributesCondition) GetGroupAttributeKey() []string {
+	if x != nil {
+		return x.GroupAttributeKey
+	}
+	return nil
+}
+
+type ContextInLiteralSetCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subjects []*Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	ContextKey string   `protobuf:"bytes,1,opt,name=context_key,json=contextKey,proto3" json:"context_key,omitempty"`
+	Literal    []string `protobuf:"bytes,2,rep,name=literal,proto3" json:"literal,omitempty"`
 }
 
-func (x *SubjectsByUserIDResponse) Reset() {
-	*x = SubjectsByUserIDResponse{}
+func (x *ContextInLiteralSetCondition) Reset() {
+	*x = ContextInLiteralSetCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[22]
+		mi := &file_proto_authnz_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubjectsByUserIDResponse) String() string {
+func (x *ContextInLiteralSetCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubjectsByUserIDResponse) ProtoMessage() {}
+func (*ContextInLiteralSetCondition) ProtoMessage() {}
 
-func (x *SubjectsByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[22]
+func (x *ContextInLiteralSetCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,45 +1860,52 @@ func (x *SubjectsByUserIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubjectsByUserIDResponse.ProtoReflect.Descriptor instead.
-func (*SubjectsByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ContextInLiteralSetCondition.ProtoReflect.Descriptor instead.
+func (*ContextInLiteralSetCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SubjectsByUserIDResponse) GetSubjects() []*Subject {
+func (x *ContextInLiteralSetCondition) GetContextKey() string {
 	if x != nil {
-		return x.Subjects
+		return x.ContextKey
+	}
+	return ""
+}
+
+func (x *ContextInLiteralSetCondition) GetLiteral() []string {
+	if x != nil {
+		return x.Literal
 	}
 	return nil
 }
 
-// this creates groups when the subject is created
-type AddSubjectWithAttributesRequest struct {
+// if certain attribute is within the string literal
+type ContextInGroupAttributesInLiteralSetCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     string       `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	GroupAttributeKey string   `protobuf:"bytes,1,opt,name=group_attribute_key,json=groupAttributeKey,proto3" json:"group_attribute_key,omitempty"`
+	Literal           []string `protobuf:"bytes,2,rep,name=literal,proto3" json:"literal,omitempty"`
 }
 
-func (x *AddSubjectWithAttributesRequest) Reset() {
-	*x = AddSubjectWithAttributesRequest{}
+func (x *ContextInGroupAttributesInLiteralSetCondition) Reset() {
+	*x = ContextInGroupAttributesInLiteralSetCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[23]
+		mi := &file_proto_authnz_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSubjectWithAttributesRequest) String() string {
+func (x *ContextInGroupAttributesInLiteralSetCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubjectWithAttributesRequest) ProtoMessage() {}
+func (*ContextInGroupAttributesInLiteralSetCondition) ProtoMessage() {}
 
-func (x *AddSubjectWithAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[23]
+func (x *ContextInGroupAttributesInLiteralSetCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,52 +1916,53 @@ func (x *AddSubjectWithAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubjectWithAttributesRequest.ProtoReflect.Descriptor instead.
-func (*AddSubjectWithAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ContextInGroupAttributesInLiteralSetCondition.ProtoReflect.Descriptor instead.
+func (*ContextInGroupAttributesInLiteralSetCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddSubjectWithAttributesRequest) GetUserId() string {
+func (x *ContextInGroupAttributesInLiteralSetCondition) GetGroupAttributeKey() string {
 	if x != nil {
-		return x.UserId
+		return x.GroupAttributeKey
 	}
 	return ""
 }
 
-func (x *AddSubjectWithAttributesRequest) GetAttributes() []*Attribute {
+func (x *ContextInGroupAttributesInLiteralSetCondition) GetLiteral() []string {
 	if x != nil {
-		return x.Attributes
+		return x.Literal
 	}
 	return nil
 }
 
-type AddSubjectWithAttributesResponse struct {
+// evaluates an attribute of the authorized resource, denied when the resource does not have it
+type ResourceAttributeCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subject    *Subject `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	ContractId string   `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Group      *Group   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	AttributeKey string    `protobuf:"bytes,1,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	Op           Operation `protobuf:"varint,2,opt,name=op,proto3,enum=com.github.dlshle.authnz.Operation" json:"op,omitempty"`
+	Value        string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AddSubjectWithAttributesResponse) Reset() {
-	*x = AddSubjectWithAttributesResponse{}
+func (x *ResourceAttributeCondition) Reset() {
+	*x = ResourceAttributeCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[24]
+		mi := &file_proto_authnz_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSubjectWithAttributesResponse) String() string {
+func (x *ResourceAttributeCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubjectWithAttributesResponse) ProtoMessage() {}
+func (*ResourceAttributeCondition) ProtoMessage() {}
 
-func (x *AddSubjectWithAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[24]
+func (x *ResourceAttributeCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,57 +1973,60 @@ func (x *AddSubjectWithAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubjectWithAttributesResponse.ProtoReflect.Descriptor instead.
-func (*AddSubjectWithAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ResourceAttributeCondition.ProtoReflect.Descriptor instead.
+func (*ResourceAttributeCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddSubjectWithAttributesResponse) GetSubject() *Subject {
+func (x *ResourceAttributeCondition) GetAttributeKey() string {
 	if x != nil {
-		return x.Subject
+		return x.AttributeKey
 	}
-	return nil
+	return ""
 }
 
-func (x *AddSubjectWithAttributesResponse) GetContractId() string {
+func (x *ResourceAttributeCondition) GetOp() Operation {
 	if x != nil {
-		return x.ContractId
+		return x.Op
 	}
-	return ""
+	return Operation_EQ
 }
 
-func (x *AddSubjectWithAttributesResponse) GetGroup() *Group {
+func (x *ResourceAttributeCondition) GetValue() string {
 	if x != nil {
-		return x.Group
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type GroupRequest struct {
+// evaluates "resource attribute op group attribute", e.g. the department of a document EQ the department of the subject
+type ResourceMatchesGroupAttributeCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	ResourceAttributeKey string    `protobuf:"bytes,1,opt,name=resource_attribute_key,json=resourceAttributeKey,proto3" json:"resource_attribute_key,omitempty"`
+	Op                   Operation `protobuf:"varint,2,opt,name=op,proto3,enum=com.github.dlshle.authnz.Operation" json:"op,omitempty"`
+	GroupAttributeKey    string    `protobuf:"bytes,3,opt,name=group_attribute_key,json=groupAttributeKey,proto3" json:"group_attribute_key,omitempty"`
 }
 
-func (x *GroupRequest) Reset() {
-	*x = GroupRequest{}
+func (x *ResourceMatchesGroupAttributeCondition) Reset() {
+	*x = ResourceMatchesGroupAttributeCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[25]
+		mi := &file_proto_authnz_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRequest) String() string {
+func (x *ResourceMatchesGroupAttributeCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRequest) ProtoMessage() {}
+func (*ResourceMatchesGroupAttributeCondition) ProtoMessage() {}
 
-func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[25]
+func (x *ResourceMatchesGroupAttributeCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,43 +2037,57 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
-func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ResourceMatchesGroupAttributeCondition.ProtoReflect.Descriptor instead.
+func (*ResourceMatchesGroupAttributeCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GroupRequest) GetGroup() *Group {
+func (x *ResourceMatchesGroupAttributeCondition) GetResourceAttributeKey() string {
 	if x != nil {
-		return x.Group
+		return x.ResourceAttributeKey
 	}
-	return nil
+	return ""
 }
 
-type GroupResponse struct {
+func (x *ResourceMatchesGroupAttributeCondition) GetOp() Operation {
+	if x != nil {
+		return x.Op
+	}
+	return Operation_EQ
+}
+
+func (x *ResourceMatchesGroupAttributeCondition) GetGroupAttributeKey() string {
+	if x != nil {
+		return x.GroupAttributeKey
+	}
+	return ""
+}
+
+type NegationCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Condition *PolicyCondition `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *GroupResponse) Reset() {
-	*x = GroupResponse{}
+func (x *NegationCondition) Reset() {
+	*x = NegationCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[26]
+		mi := &file_proto_authnz_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupResponse) String() string {
+func (x *NegationCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupResponse) ProtoMessage() {}
+func (*NegationCondition) ProtoMessage() {}
 
-func (x *GroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[26]
+func (x *NegationCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,43 +2098,43 @@ func (x *GroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
-func (*GroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use NegationCondition.ProtoReflect.Descriptor instead.
+func (*NegationCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GroupResponse) GetGroup() *Group {
+func (x *NegationCondition) GetCondition() *PolicyCondition {
 	if x != nil {
-		return x.Group
+		return x.Condition
 	}
 	return nil
 }
 
-type GroupsResponse struct {
+type OrCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Condition []*PolicyCondition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *GroupsResponse) Reset() {
-	*x = GroupsResponse{}
+func (x *OrCondition) Reset() {
+	*x = OrCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[27]
+		mi := &file_proto_authnz_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupsResponse) String() string {
+func (x *OrCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupsResponse) ProtoMessage() {}
+func (*OrCondition) ProtoMessage() {}
 
-func (x *GroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[27]
+func (x *OrCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,43 +2145,43 @@ func (x *GroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupsResponse.ProtoReflect.Descriptor instead.
-func (*GroupsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use OrCondition.ProtoReflect.Descriptor instead.
+func (*OrCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GroupsResponse) GetGroups() []*Group {
+func (x *OrCondition) GetCondition() []*PolicyCondition {
 	if x != nil {
-		return x.Groups
+		return x.Condition
 	}
 	return nil
 }
 
-type GroupByIDRequest struct {
+type AndCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Condition []*PolicyCondition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *GroupByIDRequest) Reset() {
-	*x = GroupByIDRequest{}
+func (x *AndCondition) Reset() {
+	*x = AndCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[28]
+		mi := &file_proto_authnz_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupByIDRequest) String() string {
+func (x *AndCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupByIDRequest) ProtoMessage() {}
+func (*AndCondition) ProtoMessage() {}
 
-func (x *GroupByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[28]
+func (x *AndCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,43 +2192,45 @@ func (x *GroupByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupByIDRequest.ProtoReflect.Descriptor instead.
-func (*GroupByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AndCondition.ProtoReflect.Descriptor instead.
+func (*AndCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GroupByIDRequest) GetGroupId() string {
+func (x *AndCondition) GetCondition() []*PolicyCondition {
 	if x != nil {
-		return x.GroupId
+		return x.Condition
 	}
-	return ""
+	return nil
 }
 
-type PolicyRequest struct {
+// check if the current session was authenticated with a second factor
+type MFAAuthenticatedCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Policy *Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// 0 means any age
+	MaxAgeSeconds int64 `protobuf:"varint,1,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
 }
 
-func (x *PolicyRequest) Reset() {
-	*x = PolicyRequest{}
+func (x *MFAAuthenticatedCondition) Reset() {
+	*x = MFAAuthenticatedCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[29]
+		mi := &file_proto_authnz_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PolicyRequest) String() string {
+func (x *MFAAuthenticatedCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyRequest) ProtoMessage() {}
+func (*MFAAuthenticatedCondition) ProtoMessage() {}
 
-func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[29]
+func (x *MFAAuthenticatedCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,43 +2241,44 @@ func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyRequest.ProtoReflect.Descriptor instead.
-func (*PolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use MFAAuthenticatedCondition.ProtoReflect.Descriptor instead.
+func (*MFAAuthenticatedCondition) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PolicyRequest) GetPolicy() *Policy {
+func (x *MFAAuthenticatedCondition) GetMaxAgeSeconds() int64 {
 	if x != nil {
-		return x.Policy
+		return x.MaxAgeSeconds
 	}
-	return nil
+	return 0
 }
 
-type PolicyByIDRequest struct {
+type ContextProperty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *PolicyByIDRequest) Reset() {
-	*x = PolicyByIDRequest{}
+func (x *ContextProperty) Reset() {
+	*x = ContextProperty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[30]
+		mi := &file_proto_authnz_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PolicyByIDRequest) String() string {
+func (x *ContextProperty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyByIDRequest) ProtoMessage() {}
+func (*ContextProperty) ProtoMessage() {}
 
-func (x *PolicyByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[30]
+func (x *ContextProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,44 +2289,58 @@ func (x *PolicyByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyByIDRequest.ProtoReflect.Descriptor instead.
-func (*PolicyByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ContextProperty.ProtoReflect.Descriptor instead.
+func (*ContextProperty) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PolicyByIDRequest) GetPolicyId() string {
+func (x *ContextProperty) GetKey() string {
 	if x != nil {
-		return x.PolicyId
+		return x.Key
 	}
 	return ""
 }
 
-type CreateGroupForSubjectsRequest struct {
+func (x *ContextProperty) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AuthorizeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubjectIds []string     `protobuf:"bytes,1,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
-	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	SubjectId       string             `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"` // Caller will be trusted that this is authentic.
+	PolicyId        string             `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	ContextProperty []*ContextProperty `protobuf:"bytes,3,rep,name=context_property,json=contextProperty,proto3" json:"context_property,omitempty"`
+	Verbose         bool               `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	MfaSessionId    string             `protobuf:"bytes,5,opt,name=mfa_session_id,json=mfaSessionId,proto3" json:"mfa_session_id,omitempty"`
+	// without a policy id, the policies whose target matches action and the resource type are checked and all of them
+	// must permit, the verdict is DENIED when none matches
+	Action   string    `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Resource *Resource `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
 }
 
-func (x *CreateGroupForSubjectsRequest) Reset() {
-	*x = CreateGroupForSubjectsRequest{}
+func (x *AuthorizeRequest) Reset() {
+	*x = AuthorizeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[31]
+		mi := &file_proto_authnz_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGroupForSubjectsRequest) String() string {
+func (x *AuthorizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupForSubjectsRequest) ProtoMessage() {}
+func (*AuthorizeRequest) ProtoMessage() {}
 
-func (x *CreateGroupForSubjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[31]
+func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,51 +2351,89 @@ func (x *CreateGroupForSubjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupForSubjectsRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupForSubjectsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateGroupForSubjectsRequest) GetSubjectIds() []string {
+func (x *AuthorizeRequest) GetSubjectId() string {
 	if x != nil {
-		return x.SubjectIds
+		return x.SubjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateGroupForSubjectsRequest) GetAttributes() []*Attribute {
+func (x *AuthorizeRequest) GetPolicyId() string {
 	if x != nil {
-		return x.Attributes
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *AuthorizeRequest) GetContextProperty() []*ContextProperty {
+	if x != nil {
+		return x.ContextProperty
 	}
 	return nil
 }
 
-type CreateGroupForSubjectsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AuthorizeRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
 
-	Contracts []*Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Group     *Group      `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+func (x *AuthorizeRequest) GetMfaSessionId() string {
+	if x != nil {
+		return x.MfaSessionId
+	}
+	return ""
 }
 
-func (x *CreateGroupForSubjectsResponse) Reset() {
-	*x = CreateGroupForSubjectsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_authnz_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuthorizeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
+	return ""
 }
 
-func (x *CreateGroupForSubjectsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuthorizeRequest) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
 }
 
-func (*CreateGroupForSubjectsResponse) ProtoMessage() {}
+type AuthorizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CreateGroupForSubjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_authnz_proto_msgTypes[32]
+	Verdict Verdict `protobuf:"varint,1,opt,name=verdict,proto3,enum=com.github.dlshle.authnz.Verdict" json:"verdict,omitempty"`
+	// the policies checked when the server selected them by target
+	PolicyIds []string `protobuf:"bytes,2,rep,name=policy_ids,json=policyIds,proto3" json:"policy_ids,omitempty"`
+	// active break-glass contracts of the subject, the decision was made with their memberships
+	BreakGlassContractIds []string `protobuf:"bytes,3,rep,name=break_glass_contract_ids,json=breakGlassContractIds,proto3" json:"break_glass_contract_ids,omitempty"`
+}
+
+func (x *AuthorizeResponse) Reset() {
+	*x = AuthorizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponse) ProtoMessage() {}
+
+func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,35 +2444,179 @@ func (x *CreateGroupForSubjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupForSubjectsResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupForSubjectsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_authnz_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateGroupForSubjectsResponse) GetContracts() []*Contract {
+func (x *AuthorizeResponse) GetVerdict() Verdict {
 	if x != nil {
-		return x.Contracts
+		return x.Verdict
+	}
+	return Verdict_UNKNOWN
+}
+
+func (x *AuthorizeResponse) GetPolicyIds() []string {
+	if x != nil {
+		return x.PolicyIds
 	}
 	return nil
 }
 
-func (x *CreateGroupForSubjectsResponse) GetGroup() *Group {
+func (x *AuthorizeResponse) GetBreakGlassContractIds() []string {
 	if x != nil {
-		return x.Group
+		return x.BreakGlassContractIds
 	}
 	return nil
 }
 
-type ContractRequest struct {
+type AuthContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the set of properties used for authorization
+	ContextProperty []*ContextProperty `protobuf:"bytes,1,rep,name=context_property,json=contextProperty,proto3" json:"context_property,omitempty"`
+	// the subject id that will be authorized
+	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// set by the server only when a valid mfa session is presented
+	MfaAuthenticated   bool      `protobuf:"varint,3,opt,name=mfa_authenticated,json=mfaAuthenticated,proto3" json:"mfa_authenticated,omitempty"`
+	MfaAuthenticatedAt int64     `protobuf:"varint,4,opt,name=mfa_authenticated_at,json=mfaAuthenticatedAt,proto3" json:"mfa_authenticated_at,omitempty"`
+	Action             string    `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Resource           *Resource `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
 }
 
-func (x *ContractRequest) Reset() {
-	*x = ContractRequest{}
+func (x *AuthContext) Reset() {
+	*x = AuthContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthContext) ProtoMessage() {}
+
+func (x *AuthContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthContext.ProtoReflect.Descriptor instead.
+func (*AuthContext) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AuthContext) GetContextProperty() []*ContextProperty {
+	if x != nil {
+		return x.ContextProperty
+	}
+	return nil
+}
+
+func (x *AuthContext) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *AuthContext) GetMfaAuthenticated() bool {
+	if x != nil {
+		return x.MfaAuthenticated
+	}
+	return false
+}
+
+func (x *AuthContext) GetMfaAuthenticatedAt() int64 {
+	if x != nil {
+		return x.MfaAuthenticatedAt
+	}
+	return 0
+}
+
+func (x *AuthContext) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuthContext) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type AddSubjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AddSubjectRequest) Reset() {
+	*x = AddSubjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubjectRequest) ProtoMessage() {}
+
+func (x *AddSubjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubjectRequest.ProtoReflect.Descriptor instead.
+func (*AddSubjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddSubjectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddSubjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject *Subject `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *AddSubjectResponse) Reset() {
+	*x = AddSubjectResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1987,13 +2624,13 @@ func (x *ContractRequest) Reset() {
 	}
 }
 
-func (x *ContractRequest) String() string {
+func (x *AddSubjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractRequest) ProtoMessage() {}
+func (*AddSubjectResponse) ProtoMessage() {}
 
-func (x *ContractRequest) ProtoReflect() protoreflect.Message {
+func (x *AddSubjectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2005,28 +2642,28 @@ func (x *ContractRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractRequest.ProtoReflect.Descriptor instead.
-func (*ContractRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSubjectResponse.ProtoReflect.Descriptor instead.
+func (*AddSubjectResponse) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ContractRequest) GetContract() *Contract {
+func (x *AddSubjectResponse) GetSubject() *Subject {
 	if x != nil {
-		return x.Contract
+		return x.Subject
 	}
 	return nil
 }
 
-type ContractResponse struct {
+type SubjectIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 }
 
-func (x *ContractResponse) Reset() {
-	*x = ContractResponse{}
+func (x *SubjectIDRequest) Reset() {
+	*x = SubjectIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,13 +2671,13 @@ func (x *ContractResponse) Reset() {
 	}
 }
 
-func (x *ContractResponse) String() string {
+func (x *SubjectIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractResponse) ProtoMessage() {}
+func (*SubjectIDRequest) ProtoMessage() {}
 
-func (x *ContractResponse) ProtoReflect() protoreflect.Message {
+func (x *SubjectIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2052,28 +2689,30 @@ func (x *ContractResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractResponse.ProtoReflect.Descriptor instead.
-func (*ContractResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubjectIDRequest.ProtoReflect.Descriptor instead.
+func (*SubjectIDRequest) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ContractResponse) GetContract() *Contract {
+func (x *SubjectIDRequest) GetSubjectId() string {
 	if x != nil {
-		return x.Contract
+		return x.SubjectId
 	}
-	return nil
+	return ""
 }
 
-type DeleteContractRequest struct {
+type SetPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// 12 to 72 bytes, subjects sign in to the oidc provider with it and a totp code
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *DeleteContractRequest) Reset() {
-	*x = DeleteContractRequest{}
+func (x *SetPasswordRequest) Reset() {
+	*x = SetPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,13 +2720,13 @@ func (x *DeleteContractRequest) Reset() {
 	}
 }
 
-func (x *DeleteContractRequest) String() string {
+func (x *SetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContractRequest) ProtoMessage() {}
+func (*SetPasswordRequest) ProtoMessage() {}
 
-func (x *DeleteContractRequest) ProtoReflect() protoreflect.Message {
+func (x *SetPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,26 +2738,35 @@ func (x *DeleteContractRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContractRequest.ProtoReflect.Descriptor instead.
-func (*DeleteContractRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*SetPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeleteContractRequest) GetContractId() string {
+func (x *SetPasswordRequest) GetSubjectId() string {
 	if x != nil {
-		return x.ContractId
+		return x.SubjectId
 	}
 	return ""
 }
 
-type EmptyResponse struct {
+func (x *SetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SubjectsByUserIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
+func (x *SubjectsByUserIDRequest) Reset() {
+	*x = SubjectsByUserIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_authnz_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,13 +2774,13 @@ func (x *EmptyResponse) Reset() {
 	}
 }
 
-func (x *EmptyResponse) String() string {
+func (x *SubjectsByUserIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyResponse) ProtoMessage() {}
+func (*SubjectsByUserIDRequest) ProtoMessage() {}
 
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+func (x *SubjectsByUserIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_authnz_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2144,546 +2792,4839 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubjectsByUserIDRequest.ProtoReflect.Descriptor instead.
+func (*SubjectsByUserIDRequest) Descriptor() ([]byte, []int) {
 	return file_proto_authnz_proto_rawDescGZIP(), []int{36}
 }
 
-var File_proto_authnz_proto protoreflect.FileDescriptor
+func (x *SubjectsByUserIDRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
 
-var file_proto_authnz_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x22, 0x32,
-	0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x5c, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x22, 0x33, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x54, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x06, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89,
-	0x06, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x48, 0x61, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x68,
-	0x61, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x12, 0x65,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x6e, 0x7a, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4f, 0x50, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
-	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x18, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x16, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x18, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61,
-	0x6c, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x49,
-	0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x15, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x49, 0x0a,
-	0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4e, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08,
-	0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
-	0x4f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x6f,
-	0x72, 0x12, 0x3a, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x42, 0x0b, 0x0a,
-	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x16, 0x48, 0x61,
-	0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4f, 0x50, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x74, 0x0a, 0x21, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x59, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x22, 0x79, 0x0a, 0x2d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e,
-	0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x5c, 0x0a,
-	0x11, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x0b, 0x4f,
-	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
-	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x0c, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0f,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63,
-	0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22,
-	0x2c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x22, 0x31, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x22, 0x7f, 0x0a, 0x1f, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43,
-	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x20, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x45, 0x0a,
-	0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x49, 0x0a, 0x0e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2d, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x0d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x1e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
-	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
-	0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x51, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x52, 0x0a, 0x10, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
-	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x38,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x43, 0x0a, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x51, 0x10, 0x00, 0x12, 0x06,
-	0x0a, 0x02, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x54, 0x10, 0x02, 0x12, 0x07,
-	0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10, 0x04,
-	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x05, 0x2a, 0x31,
-	0x0a, 0x07, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x32, 0xe3, 0x0f, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x5a, 0x12, 0x64, 0x0a, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x67, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
-	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
-	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x67,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x91, 0x01, 0x0a, 0x18, 0x61, 0x64, 0x64,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
-	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a,
-	0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x46, 0x6f, 0x72,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
-	0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
-	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x14, 0x66,
-	0x69, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5e, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5f, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6c, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5e, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
-	0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
-	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5a, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x59, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
-	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x64, 0x0a, 0x0c,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
-	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
-	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x7a, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+type SubjectsByUserIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subjects []*Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
 }
 
-var (
-	file_proto_authnz_proto_rawDescOnce sync.Once
-	file_proto_authnz_proto_rawDescData = file_proto_authnz_proto_rawDesc
-)
+func (x *SubjectsByUserIDResponse) Reset() {
+	*x = SubjectsByUserIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_authnz_proto_rawDescGZIP() []byte {
-	file_proto_authnz_proto_rawDescOnce.Do(func() {
-		file_proto_authnz_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_authnz_proto_rawDescData)
-	})
-	return file_proto_authnz_proto_rawDescData
+func (x *SubjectsByUserIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_authnz_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_authnz_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_proto_authnz_proto_goTypes = []interface{}{
-	(Operation)(0),                            // 0: com.github.dlshle.authnz.Operation
-	(Verdict)(0),                              // 1: com.github.dlshle.authnz.Verdict
-	(*Subject)(nil),                           // 2: com.github.dlshle.authnz.Subject
-	(*Group)(nil),                             // 3: com.github.dlshle.authnz.Group
-	(*Attribute)(nil),                         // 4: com.github.dlshle.authnz.Attribute
-	(*Contract)(nil),                          // 5: com.github.dlshle.authnz.Contract
-	(*Policy)(nil),                            // 6: com.github.dlshle.authnz.Policy
-	(*PolicyCondition)(nil),                   // 7: com.github.dlshle.authnz.PolicyCondition
-	(*HasAttributesCondition)(nil),            // 8: com.github.dlshle.authnz.HasAttributesCondition
-	(*EvaluateOPCondition)(nil),               // 9: com.github.dlshle.authnz.EvaluateOPCondition
-	(*ContextInGroupAttributesCondition)(nil), // 10: com.github.dlshle.authnz.ContextInGroupAttributesCondition
-	(*ContextInLiteralSetCondition)(nil),      // 11: com.github.dlshle.authnz.ContextInLiteralSetCondition
-	(*ContextInGroupAttributesInLiteralSetCondition)(nil), // 12: com.github.dlshle.authnz.ContextInGroupAttributesInLiteralSetCondition
-	(*NegationCondition)(nil),                             // 13: com.github.dlshle.authnz.NegationCondition
-	(*OrCondition)(nil),                                   // 14: com.github.dlshle.authnz.OrCondition
-	(*AndCondition)(nil),                                  // 15: com.github.dlshle.authnz.AndCondition
-	(*ContextProperty)(nil),                               // 16: com.github.dlshle.authnz.ContextProperty
-	(*AuthorizeRequest)(nil),                              // 17: com.github.dlshle.authnz.AuthorizeRequest
-	(*AuthorizeResponse)(nil),                             // 18: com.github.dlshle.authnz.AuthorizeResponse
-	(*AuthContext)(nil),                                   // 19: com.github.dlshle.authnz.AuthContext
-	(*AddSubjectRequest)(nil),                             // 20: com.github.dlshle.authnz.AddSubjectRequest
-	(*AddSubjectResponse)(nil),                            // 21: com.github.dlshle.authnz.AddSubjectResponse
-	(*SubjectIDRequest)(nil),                              // 22: com.github.dlshle.authnz.SubjectIDRequest
-	(*SubjectsByUserIDRequest)(nil),                       // 23: com.github.dlshle.authnz.SubjectsByUserIDRequest
-	(*SubjectsByUserIDResponse)(nil),                      // 24: com.github.dlshle.authnz.SubjectsByUserIDResponse
-	(*AddSubjectWithAttributesRequest)(nil),               // 25: com.github.dlshle.authnz.AddSubjectWithAttributesRequest
-	(*AddSubjectWithAttributesResponse)(nil),              // 26: com.github.dlshle.authnz.AddSubjectWithAttributesResponse
-	(*GroupRequest)(nil),                                  // 27: com.github.dlshle.authnz.GroupRequest
-	(*GroupResponse)(nil),                                 // 28: com.github.dlshle.authnz.GroupResponse
-	(*GroupsResponse)(nil),                                // 29: com.github.dlshle.authnz.GroupsResponse
-	(*GroupByIDRequest)(nil),                              // 30: com.github.dlshle.authnz.GroupByIDRequest
-	(*PolicyRequest)(nil),                                 // 31: com.github.dlshle.authnz.PolicyRequest
-	(*PolicyByIDRequest)(nil),                             // 32: com.github.dlshle.authnz.PolicyByIDRequest
-	(*CreateGroupForSubjectsRequest)(nil),                 // 33: com.github.dlshle.authnz.CreateGroupForSubjectsRequest
-	(*CreateGroupForSubjectsResponse)(nil),                // 34: com.github.dlshle.authnz.CreateGroupForSubjectsResponse
-	(*ContractRequest)(nil),                               // 35: com.github.dlshle.authnz.ContractRequest
-	(*ContractResponse)(nil),                              // 36: com.github.dlshle.authnz.ContractResponse
-	(*DeleteContractRequest)(nil),                         // 37: com.github.dlshle.authnz.DeleteContractRequest
-	(*EmptyResponse)(nil),                                 // 38: com.github.dlshle.authnz.EmptyResponse
+func (*SubjectsByUserIDResponse) ProtoMessage() {}
+
+func (x *SubjectsByUserIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_authnz_proto_depIdxs = []int32{
-	4,  // 0: com.github.dlshle.authnz.Group.attributes:type_name -> com.github.dlshle.authnz.Attribute
-	7,  // 1: com.github.dlshle.authnz.Policy.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
-	8,  // 2: com.github.dlshle.authnz.PolicyCondition.has_attribute:type_name -> com.github.dlshle.authnz.HasAttributesCondition
-	9,  // 3: com.github.dlshle.authnz.PolicyCondition.evaluate_attribute:type_name -> com.github.dlshle.authnz.EvaluateOPCondition
-	10, // 4: com.github.dlshle.authnz.PolicyCondition.context_in_group_attributes:type_name -> com.github.dlshle.authnz.ContextInGroupAttributesCondition
-	11, // 5: com.github.dlshle.authnz.PolicyCondition.context_in_literal_set:type_name -> com.github.dlshle.authnz.ContextInLiteralSetCondition
-	12, // 6: com.github.dlshle.authnz.PolicyCondition.attribute_in_literal_set:type_name -> com.github.dlshle.authnz.ContextInGroupAttributesInLiteralSetCondition
-	13, // 7: com.github.dlshle.authnz.PolicyCondition.negation:type_name -> com.github.dlshle.authnz.NegationCondition
-	14, // 8: com.github.dlshle.authnz.PolicyCondition.or:type_name -> com.github.dlshle.authnz.OrCondition
-	15, // 9: com.github.dlshle.authnz.PolicyCondition.and:type_name -> com.github.dlshle.authnz.AndCondition
-	0,  // 10: com.github.dlshle.authnz.EvaluateOPCondition.op:type_name -> com.github.dlshle.authnz.Operation
-	7,  // 11: com.github.dlshle.authnz.NegationCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
-	7,  // 12: com.github.dlshle.authnz.OrCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
-	7,  // 13: com.github.dlshle.authnz.AndCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
-	16, // 14: com.github.dlshle.authnz.AuthorizeRequest.context_property:type_name -> com.github.dlshle.authnz.ContextProperty
-	1,  // 15: com.github.dlshle.authnz.AuthorizeResponse.verdict:type_name -> com.github.dlshle.authnz.Verdict
-	16, // 16: com.github.dlshle.authnz.AuthContext.context_property:type_name -> com.github.dlshle.authnz.ContextProperty
-	2,  // 17: com.github.dlshle.authnz.AddSubjectResponse.subject:type_name -> com.github.dlshle.authnz.Subject
-	2,  // 18: com.github.dlshle.authnz.SubjectsByUserIDResponse.subjects:type_name -> com.github.dlshle.authnz.Subject
-	4,  // 19: com.github.dlshle.authnz.AddSubjectWithAttributesRequest.attributes:type_name -> com.github.dlshle.authnz.Attribute
-	2,  // 20: com.github.dlshle.authnz.AddSubjectWithAttributesResponse.subject:type_name -> com.github.dlshle.authnz.Subject
-	3,  // 21: com.github.dlshle.authnz.AddSubjectWithAttributesResponse.group:type_name -> com.github.dlshle.authnz.Group
-	3,  // 22: com.github.dlshle.authnz.GroupRequest.group:type_name -> com.github.dlshle.authnz.Group
-	3,  // 23: com.github.dlshle.authnz.GroupResponse.group:type_name -> com.github.dlshle.authnz.Group
-	3,  // 24: com.github.dlshle.authnz.GroupsResponse.groups:type_name -> com.github.dlshle.authnz.Group
-	6,  // 25: com.github.dlshle.authnz.PolicyRequest.policy:type_name -> com.github.dlshle.authnz.Policy
-	4,  // 26: com.github.dlshle.authnz.CreateGroupForSubjectsRequest.attributes:type_name -> com.github.dlshle.authnz.Attribute
-	5,  // 27: com.github.dlshle.authnz.CreateGroupForSubjectsResponse.contracts:type_name -> com.github.dlshle.authnz.Contract
-	3,  // 28: com.github.dlshle.authnz.CreateGroupForSubjectsResponse.group:type_name -> com.github.dlshle.authnz.Group
-	5,  // 29: com.github.dlshle.authnz.ContractRequest.contract:type_name -> com.github.dlshle.authnz.Contract
-	5,  // 30: com.github.dlshle.authnz.ContractResponse.contract:type_name -> com.github.dlshle.authnz.Contract
-	17, // 31: com.github.dlshle.authnz.AuthNZ.authorize:input_type -> com.github.dlshle.authnz.AuthorizeRequest
-	20, // 32: com.github.dlshle.authnz.AuthNZ.addSubject:input_type -> com.github.dlshle.authnz.AddSubjectRequest
-	22, // 33: com.github.dlshle.authnz.AuthNZ.getSubject:input_type -> com.github.dlshle.authnz.SubjectIDRequest
-	25, // 34: com.github.dlshle.authnz.AuthNZ.addSubjectWithAttributes:input_type -> com.github.dlshle.authnz.AddSubjectWithAttributesRequest
-	33, // 35: com.github.dlshle.authnz.AuthNZ.createGroupsForSubjects:input_type -> com.github.dlshle.authnz.CreateGroupForSubjectsRequest
-	23, // 36: com.github.dlshle.authnz.AuthNZ.findSubjectsByUserID:input_type -> com.github.dlshle.authnz.SubjectsByUserIDRequest
-	22, // 37: com.github.dlshle.authnz.AuthNZ.deleteSubject:input_type -> com.github.dlshle.authnz.SubjectIDRequest
-	27, // 38: com.github.dlshle.authnz.AuthNZ.createGroup:input_type -> com.github.dlshle.authnz.GroupRequest
-	30, // 39: com.github.dlshle.authnz.AuthNZ.getGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
-	22, // 40: com.github.dlshle.authnz.AuthNZ.getGroupsBySubjectID:input_type -> com.github.dlshle.authnz.SubjectIDRequest
-	27, // 41: com.github.dlshle.authnz.AuthNZ.updateGroup:input_type -> com.github.dlshle.authnz.GroupRequest
-	30, // 42: com.github.dlshle.authnz.AuthNZ.deleteGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
-	30, // 43: com.github.dlshle.authnz.AuthNZ.duplicateGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
-	31, // 44: com.github.dlshle.authnz.AuthNZ.createPolicy:input_type -> com.github.dlshle.authnz.PolicyRequest
-	32, // 45: com.github.dlshle.authnz.AuthNZ.getPolicy:input_type -> com.github.dlshle.authnz.PolicyByIDRequest
-	31, // 46: com.github.dlshle.authnz.AuthNZ.updatePolicy:input_type -> com.github.dlshle.authnz.PolicyRequest
-	32, // 47: com.github.dlshle.authnz.AuthNZ.deletePolicy:input_type -> com.github.dlshle.authnz.PolicyByIDRequest
-	35, // 48: com.github.dlshle.authnz.AuthNZ.createContract:input_type -> com.github.dlshle.authnz.ContractRequest
-	37, // 49: com.github.dlshle.authnz.AuthNZ.deleteContract:input_type -> com.github.dlshle.authnz.DeleteContractRequest
-	18, // 50: com.github.dlshle.authnz.AuthNZ.authorize:output_type -> com.github.dlshle.authnz.AuthorizeResponse
-	21, // 51: com.github.dlshle.authnz.AuthNZ.addSubject:output_type -> com.github.dlshle.authnz.AddSubjectResponse
-	2,  // 52: com.github.dlshle.authnz.AuthNZ.getSubject:output_type -> com.github.dlshle.authnz.Subject
-	26, // 53: com.github.dlshle.authnz.AuthNZ.addSubjectWithAttributes:output_type -> com.github.dlshle.authnz.AddSubjectWithAttributesResponse
-	34, // 54: com.github.dlshle.authnz.AuthNZ.createGroupsForSubjects:output_type -> com.github.dlshle.authnz.CreateGroupForSubjectsResponse
-	24, // 55: com.github.dlshle.authnz.AuthNZ.findSubjectsByUserID:output_type -> com.github.dlshle.authnz.SubjectsByUserIDResponse
-	38, // 56: com.github.dlshle.authnz.AuthNZ.deleteSubject:output_type -> com.github.dlshle.authnz.EmptyResponse
-	28, // 57: com.github.dlshle.authnz.AuthNZ.createGroup:output_type -> com.github.dlshle.authnz.GroupResponse
-	28, // 58: com.github.dlshle.authnz.AuthNZ.getGroup:output_type -> com.github.dlshle.authnz.GroupResponse
-	29, // 59: com.github.dlshle.authnz.AuthNZ.getGroupsBySubjectID:output_type -> com.github.dlshle.authnz.GroupsResponse
-	28, // 60: com.github.dlshle.authnz.AuthNZ.updateGroup:output_type -> com.github.dlshle.authnz.GroupResponse
-	38, // 61: com.github.dlshle.authnz.AuthNZ.deleteGroup:output_type -> com.github.dlshle.authnz.EmptyResponse
-	28, // 62: com.github.dlshle.authnz.AuthNZ.duplicateGroup:output_type -> com.github.dlshle.authnz.GroupResponse
-	6,  // 63: com.github.dlshle.authnz.AuthNZ.createPolicy:output_type -> com.github.dlshle.authnz.Policy
-	6,  // 64: com.github.dlshle.authnz.AuthNZ.getPolicy:output_type -> com.github.dlshle.authnz.Policy
-	6,  // 65: com.github.dlshle.authnz.AuthNZ.updatePolicy:output_type -> com.github.dlshle.authnz.Policy
-	38, // 66: com.github.dlshle.authnz.AuthNZ.deletePolicy:output_type -> com.github.dlshle.authnz.EmptyResponse
-	36, // 67: com.github.dlshle.authnz.AuthNZ.createContract:output_type -> com.github.dlshle.authnz.ContractResponse
-	38, // 68: com.github.dlshle.authnz.AuthNZ.deleteContract:output_type -> com.github.dlshle.authnz.EmptyResponse
-	50, // [50:69] is the sub-list for method output_type
-	31, // [31:50] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+
+// Deprecated: Use SubjectsByUserIDResponse.ProtoReflect.Descriptor instead.
+func (*SubjectsByUserIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{37}
 }
 
-func init() { file_proto_authnz_proto_init() }
-func file_proto_authnz_proto_init() {
-	if File_proto_authnz_proto != nil {
-		return
+func (x *SubjectsByUserIDResponse) GetSubjects() []*Subject {
+	if x != nil {
+		return x.Subjects
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_authnz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subject); i {
+	return nil
+}
+
+// this creates groups when the subject is created
+type AddSubjectWithAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string       `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *AddSubjectWithAttributesRequest) Reset() {
+	*x = AddSubjectWithAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubjectWithAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubjectWithAttributesRequest) ProtoMessage() {}
+
+func (x *AddSubjectWithAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubjectWithAttributesRequest.ProtoReflect.Descriptor instead.
+func (*AddSubjectWithAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddSubjectWithAttributesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddSubjectWithAttributesRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type AddSubjectWithAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject    *Subject `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	ContractId string   `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Group      *Group   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *AddSubjectWithAttributesResponse) Reset() {
+	*x = AddSubjectWithAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubjectWithAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubjectWithAttributesResponse) ProtoMessage() {}
+
+func (x *AddSubjectWithAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubjectWithAttributesResponse.ProtoReflect.Descriptor instead.
+func (*AddSubjectWithAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddSubjectWithAttributesResponse) GetSubject() *Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *AddSubjectWithAttributesResponse) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *AddSubjectWithAttributesResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *GroupResponse) Reset() {
+	*x = GroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupResponse) ProtoMessage() {}
+
+func (x *GroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
+func (*GroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GroupsResponse) Reset() {
+	*x = GroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupsResponse) ProtoMessage() {}
+
+func (x *GroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupsResponse.ProtoReflect.Descriptor instead.
+func (*GroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListGroupsByAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes []*Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ListGroupsByAttributesRequest) Reset() {
+	*x = ListGroupsByAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupsByAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsByAttributesRequest) ProtoMessage() {}
+
+func (x *ListGroupsByAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsByAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsByAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListGroupsByAttributesRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GroupByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GroupByIDRequest) Reset() {
+	*x = GroupByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupByIDRequest) ProtoMessage() {}
+
+func (x *GroupByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupByIDRequest.ProtoReflect.Descriptor instead.
+func (*GroupByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GroupByIDRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GroupParentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *GroupParentRequest) Reset() {
+	*x = GroupParentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupParentRequest) ProtoMessage() {}
+
+func (x *GroupParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupParentRequest.ProtoReflect.Descriptor instead.
+func (*GroupParentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GroupParentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupParentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type GroupRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RoleId  string `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *GroupRoleRequest) Reset() {
+	*x = GroupRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRoleRequest) ProtoMessage() {}
+
+func (x *GroupRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRoleRequest.ProtoReflect.Descriptor instead.
+func (*GroupRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GroupRoleRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type RoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *RoleRequest) Reset() {
+	*x = RoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRequest) ProtoMessage() {}
+
+func (x *RoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
+func (*RoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RoleRequest) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type RoleByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *RoleByIDRequest) Reset() {
+	*x = RoleByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleByIDRequest) ProtoMessage() {}
+
+func (x *RoleByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleByIDRequest.ProtoReflect.Descriptor instead.
+func (*RoleByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RoleByIDRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId  string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CheckPermissionRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verdict Verdict `protobuf:"varint,1,opt,name=verdict,proto3,enum=com.github.dlshle.authnz.Verdict" json:"verdict,omitempty"`
+	// the roles granting the permission when the verdict is PERMITTED
+	RoleIds []string `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	// active break-glass contracts of the subject, the decision was made with their memberships
+	BreakGlassContractIds []string `protobuf:"bytes,3,rep,name=break_glass_contract_ids,json=breakGlassContractIds,proto3" json:"break_glass_contract_ids,omitempty"`
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CheckPermissionResponse) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_UNKNOWN
+}
+
+func (x *CheckPermissionResponse) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CheckPermissionResponse) GetBreakGlassContractIds() []string {
+	if x != nil {
+		return x.BreakGlassContractIds
+	}
+	return nil
+}
+
+// deletes are applied before writes, all in one transaction
+type WriteRelationTuplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Writes  []*RelationTuple `protobuf:"bytes,1,rep,name=writes,proto3" json:"writes,omitempty"`
+	Deletes []*RelationTuple `protobuf:"bytes,2,rep,name=deletes,proto3" json:"deletes,omitempty"`
+}
+
+func (x *WriteRelationTuplesRequest) Reset() {
+	*x = WriteRelationTuplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRelationTuplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRelationTuplesRequest) ProtoMessage() {}
+
+func (x *WriteRelationTuplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRelationTuplesRequest.ProtoReflect.Descriptor instead.
+func (*WriteRelationTuplesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WriteRelationTuplesRequest) GetWrites() []*RelationTuple {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+func (x *WriteRelationTuplesRequest) GetDeletes() []*RelationTuple {
+	if x != nil {
+		return x.Deletes
+	}
+	return nil
+}
+
+type CheckRelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object    *RelationObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Relation  string          `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	SubjectId string          `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+}
+
+func (x *CheckRelationRequest) Reset() {
+	*x = CheckRelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRelationRequest) ProtoMessage() {}
+
+func (x *CheckRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRelationRequest.ProtoReflect.Descriptor instead.
+func (*CheckRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CheckRelationRequest) GetObject() *RelationObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *CheckRelationRequest) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *CheckRelationRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type CheckRelationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verdict Verdict `protobuf:"varint,1,opt,name=verdict,proto3,enum=com.github.dlshle.authnz.Verdict" json:"verdict,omitempty"`
+}
+
+func (x *CheckRelationResponse) Reset() {
+	*x = CheckRelationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRelationResponse) ProtoMessage() {}
+
+func (x *CheckRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRelationResponse.ProtoReflect.Descriptor instead.
+func (*CheckRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CheckRelationResponse) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_UNKNOWN
+}
+
+type ExpandRelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object   *RelationObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Relation string          `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+}
+
+func (x *ExpandRelationRequest) Reset() {
+	*x = ExpandRelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpandRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRelationRequest) ProtoMessage() {}
+
+func (x *ExpandRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandRelationRequest.ProtoReflect.Descriptor instead.
+func (*ExpandRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExpandRelationRequest) GetObject() *RelationObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *ExpandRelationRequest) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+type PolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *PolicyRequest) Reset() {
+	*x = PolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRequest) ProtoMessage() {}
+
+func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRequest.ProtoReflect.Descriptor instead.
+func (*PolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PolicyRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PolicyByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+}
+
+func (x *PolicyByIDRequest) Reset() {
+	*x = PolicyByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyByIDRequest) ProtoMessage() {}
+
+func (x *PolicyByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyByIDRequest.ProtoReflect.Descriptor instead.
+func (*PolicyByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PolicyByIDRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type CreateGroupForSubjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectIds []string     `protobuf:"bytes,1,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *CreateGroupForSubjectsRequest) Reset() {
+	*x = CreateGroupForSubjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupForSubjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupForSubjectsRequest) ProtoMessage() {}
+
+func (x *CreateGroupForSubjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupForSubjectsRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupForSubjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateGroupForSubjectsRequest) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+func (x *CreateGroupForSubjectsRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateGroupForSubjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contracts []*Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Group     *Group      `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CreateGroupForSubjectsResponse) Reset() {
+	*x = CreateGroupForSubjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupForSubjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupForSubjectsResponse) ProtoMessage() {}
+
+func (x *CreateGroupForSubjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupForSubjectsResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupForSubjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateGroupForSubjectsResponse) GetContracts() []*Contract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *CreateGroupForSubjectsResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (x *ContractRequest) Reset() {
+	*x = ContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRequest) ProtoMessage() {}
+
+func (x *ContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRequest.ProtoReflect.Descriptor instead.
+func (*ContractRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ContractRequest) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+type ContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (x *ContractResponse) Reset() {
+	*x = ContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractResponse) ProtoMessage() {}
+
+func (x *ContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractResponse.ProtoReflect.Descriptor instead.
+func (*ContractResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ContractResponse) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+// replaces the window of a contract, e.g. to extend or shorten a temporary membership
+type ContractWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	NotBefore  int64  `protobuf:"varint,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ContractWindowRequest) Reset() {
+	*x = ContractWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractWindowRequest) ProtoMessage() {}
+
+func (x *ContractWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractWindowRequest.ProtoReflect.Descriptor instead.
+func (*ContractWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ContractWindowRequest) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *ContractWindowRequest) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *ContractWindowRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListGroupMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// defaults to 100, at most 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListGroupMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GroupMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contracts of the group ordered by subject id, including ones not in effect
+	Members []*Contract `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GroupMembersResponse) Reset() {
+	*x = GroupMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembersResponse) ProtoMessage() {}
+
+func (x *GroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*GroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GroupMembersResponse) GetMembers() []*Contract {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GroupMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddSubjectsToGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId    string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SubjectIds []string `protobuf:"bytes,2,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+	// window of the added contracts
+	NotBefore int64 `protobuf:"varint,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *AddSubjectsToGroupRequest) Reset() {
+	*x = AddSubjectsToGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubjectsToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubjectsToGroupRequest) ProtoMessage() {}
+
+func (x *AddSubjectsToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubjectsToGroupRequest.ProtoReflect.Descriptor instead.
+func (*AddSubjectsToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddSubjectsToGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddSubjectsToGroupRequest) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+func (x *AddSubjectsToGroupRequest) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *AddSubjectsToGroupRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RemoveSubjectsFromGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId    string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SubjectIds []string `protobuf:"bytes,2,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+}
+
+func (x *RemoveSubjectsFromGroupRequest) Reset() {
+	*x = RemoveSubjectsFromGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSubjectsFromGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubjectsFromGroupRequest) ProtoMessage() {}
+
+func (x *RemoveSubjectsFromGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubjectsFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSubjectsFromGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveSubjectsFromGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveSubjectsFromGroupRequest) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+type SubjectMembershipResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// the added contract, or the removed one
+	Contract *Contract `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// grpc status code of the subject's change, 0 when it succeeded
+	Code    int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SubjectMembershipResult) Reset() {
+	*x = SubjectMembershipResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubjectMembershipResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectMembershipResult) ProtoMessage() {}
+
+func (x *SubjectMembershipResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectMembershipResult.ProtoReflect.Descriptor instead.
+func (*SubjectMembershipResult) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SubjectMembershipResult) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SubjectMembershipResult) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *SubjectMembershipResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SubjectMembershipResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GroupMembershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SubjectMembershipResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GroupMembershipResponse) Reset() {
+	*x = GroupMembershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembershipResponse) ProtoMessage() {}
+
+func (x *GroupMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembershipResponse.ProtoReflect.Descriptor instead.
+func (*GroupMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GroupMembershipResponse) GetResults() []*SubjectMembershipResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RequestAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId       string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	GroupId         string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationSeconds int64  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (x *RequestAccessRequest) Reset() {
+	*x = RequestAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccessRequest) ProtoMessage() {}
+
+func (x *RequestAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RequestAccessRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *RequestAccessRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RequestAccessRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestAccessRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type AccessRequestByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// the subject acting on the request, taken from the caller's credentials when authentication is enabled
+	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+}
+
+func (x *AccessRequestByIDRequest) Reset() {
+	*x = AccessRequestByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRequestByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequestByIDRequest) ProtoMessage() {}
+
+func (x *AccessRequestByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequestByIDRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequestByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AccessRequestByIDRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AccessRequestByIDRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type AccessDecisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// taken from the caller's credentials when authentication is enabled
+	ApproverId string `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AccessDecisionRequest) Reset() {
+	*x = AccessDecisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessDecisionRequest) ProtoMessage() {}
+
+func (x *AccessDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessDecisionRequest.ProtoReflect.Descriptor instead.
+func (*AccessDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AccessDecisionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AccessDecisionRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *AccessDecisionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListPendingAccessRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// taken from the caller's credentials when authentication is enabled
+	ApproverId string `protobuf:"bytes,1,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+}
+
+func (x *ListPendingAccessRequestsRequest) Reset() {
+	*x = ListPendingAccessRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListPendingAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListPendingAccessRequestsRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+type AccessRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*AccessRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *AccessRequestsResponse) Reset() {
+	*x = AccessRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequestsResponse) ProtoMessage() {}
+
+func (x *AccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*AccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AccessRequestsResponse) GetRequests() []*AccessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BreakGlassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	GroupId   string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// required, kept on the contract
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	// defaults to and may not exceed contracts.break_glass_max_duration_seconds
+	DurationSeconds int64 `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (x *BreakGlassRequest) Reset() {
+	*x = BreakGlassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakGlassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakGlassRequest) ProtoMessage() {}
+
+func (x *BreakGlassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakGlassRequest.ProtoReflect.Descriptor instead.
+func (*BreakGlassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BreakGlassRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type SoDRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *SoDRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *SoDRuleRequest) Reset() {
+	*x = SoDRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoDRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDRuleRequest) ProtoMessage() {}
+
+func (x *SoDRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDRuleRequest.ProtoReflect.Descriptor instead.
+func (*SoDRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SoDRuleRequest) GetRule() *SoDRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SoDRuleByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *SoDRuleByIDRequest) Reset() {
+	*x = SoDRuleByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoDRuleByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDRuleByIDRequest) ProtoMessage() {}
+
+func (x *SoDRuleByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDRuleByIDRequest.ProtoReflect.Descriptor instead.
+func (*SoDRuleByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SoDRuleByIDRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type ListSoDRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSoDRulesRequest) Reset() {
+	*x = ListSoDRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSoDRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoDRulesRequest) ProtoMessage() {}
+
+func (x *ListSoDRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoDRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSoDRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{76}
+}
+
+type SoDRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*SoDRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SoDRulesResponse) Reset() {
+	*x = SoDRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoDRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDRulesResponse) ProtoMessage() {}
+
+func (x *SoDRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDRulesResponse.ProtoReflect.Descriptor instead.
+func (*SoDRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SoDRulesResponse) GetRules() []*SoDRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (x *DeleteContractRequest) Reset() {
+	*x = DeleteContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContractRequest) ProtoMessage() {}
+
+func (x *DeleteContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContractRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContractRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteContractRequest) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// shown in authenticator apps, defaults to the subject's user id
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// an mfa session of the subject, required to replace a confirmed enrollment
+	MfaSessionId string `protobuf:"bytes,3,opt,name=mfa_session_id,json=mfaSessionId,proto3" json:"mfa_session_id,omitempty"`
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *EnrollTOTPRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *EnrollTOTPRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *EnrollTOTPRequest) GetMfaSessionId() string {
+	if x != nil {
+		return x.MfaSessionId
+	}
+	return ""
+}
+
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret        string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string   `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	RecoveryCodes []string `protobuf:"bytes,3,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *VerifyTOTPRequest) Reset() {
+	*x = VerifyTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTOTPRequest) ProtoMessage() {}
+
+func (x *VerifyTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTOTPRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *VerifyTOTPRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *VerifyTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// an mfa session of the subject, required to delete a confirmed enrollment
+	MfaSessionId string `protobuf:"bytes,2,opt,name=mfa_session_id,json=mfaSessionId,proto3" json:"mfa_session_id,omitempty"`
+}
+
+func (x *DeleteTOTPRequest) Reset() {
+	*x = DeleteTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTOTPRequest) ProtoMessage() {}
+
+func (x *DeleteTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteTOTPRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *DeleteTOTPRequest) GetMfaSessionId() string {
+	if x != nil {
+		return x.MfaSessionId
+	}
+	return ""
+}
+
+type RecoveryCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId    string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	RecoveryCode string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *RecoveryCodeRequest) Reset() {
+	*x = RecoveryCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodeRequest) ProtoMessage() {}
+
+func (x *RecoveryCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodeRequest.ProtoReflect.Descriptor instead.
+func (*RecoveryCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RecoveryCodeRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *RecoveryCodeRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type MFASessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *MFASessionResponse) Reset() {
+	*x = MFASessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MFASessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFASessionResponse) ProtoMessage() {}
+
+func (x *MFASessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFASessionResponse.ProtoReflect.Descriptor instead.
+func (*MFASessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MFASessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MFASessionResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type FederatedLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id token issued by a configured upstream issuer
+	IdToken string `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+}
+
+func (x *FederatedLoginRequest) Reset() {
+	*x = FederatedLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedLoginRequest) ProtoMessage() {}
+
+func (x *FederatedLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedLoginRequest.ProtoReflect.Descriptor instead.
+func (*FederatedLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *FederatedLoginRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type FederatedLoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject *Subject `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// true when the subject was created by this login
+	Provisioned bool `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
+}
+
+func (x *FederatedLoginResponse) Reset() {
+	*x = FederatedLoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedLoginResponse) ProtoMessage() {}
+
+func (x *FederatedLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedLoginResponse.ProtoReflect.Descriptor instead.
+func (*FederatedLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FederatedLoginResponse) GetSubject() *Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *FederatedLoginResponse) GetProvisioned() bool {
+	if x != nil {
+		return x.Provisioned
+	}
+	return false
+}
+
+type LinkFederatedIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectId string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	IdToken   string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+}
+
+func (x *LinkFederatedIdentityRequest) Reset() {
+	*x = LinkFederatedIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkFederatedIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkFederatedIdentityRequest) ProtoMessage() {}
+
+func (x *LinkFederatedIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkFederatedIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkFederatedIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LinkFederatedIdentityRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *LinkFederatedIdentityRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type FederatedIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer          string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ExternalSubject string `protobuf:"bytes,2,opt,name=external_subject,json=externalSubject,proto3" json:"external_subject,omitempty"`
+	SubjectId       string `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+}
+
+func (x *FederatedIdentity) Reset() {
+	*x = FederatedIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedIdentity) ProtoMessage() {}
+
+func (x *FederatedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedIdentity.ProtoReflect.Descriptor instead.
+func (*FederatedIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FederatedIdentity) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *FederatedIdentity) GetExternalSubject() string {
+	if x != nil {
+		return x.ExternalSubject
+	}
+	return ""
+}
+
+func (x *FederatedIdentity) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type FederatedIdentitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identities []*FederatedIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+}
+
+func (x *FederatedIdentitiesResponse) Reset() {
+	*x = FederatedIdentitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedIdentitiesResponse) ProtoMessage() {}
+
+func (x *FederatedIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*FederatedIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FederatedIdentitiesResponse) GetIdentities() []*FederatedIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type EmptyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_authnz_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_authnz_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_authnz_proto_rawDescGZIP(), []int{90}
+}
+
+var File_proto_authnz_proto protoreflect.FileDescriptor
+
+var file_proto_authnz_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x22, 0x32,
+	0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x45, 0x0a,
+	0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x67, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x47,
+	0x6c, 0x61, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x0a, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xbb, 0x01, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x4f, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x04,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x07, 0x53, 0x6f, 0x44, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52,
+	0x75, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x10, 0x01, 0x22, 0x68, 0x0a, 0x08, 0x53, 0x6f, 0x44,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x41, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x22, 0xa2, 0x04, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x3e, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6a, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x22, 0xee, 0x02, 0x0a, 0x0c, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x65, 0x74, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x73, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x47, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x41, 0x46, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x22, 0xe2, 0x08, 0x0a, 0x0f,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x57, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x48, 0x61, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x12, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4f, 0x50, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x4c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x15, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x6e, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4f, 0x72, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x3a,
+	0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x62, 0x0a, 0x11, 0x6d, 0x66,
+	0x61, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x4d, 0x46, 0x41, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x66,
+	0x61, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x65,
+	0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3d, 0x0a, 0x16, 0x48, 0x61, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22,
+	0x85, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4f, 0x50, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x74, 0x0a, 0x21, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
+	0x13, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x59, 0x0a,
+	0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x79, 0x0a, 0x2d, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x26, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x11, 0x4e, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x0b, 0x4f, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57,
+	0x0a, 0x0c, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x19, 0x4d, 0x46, 0x41, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x66, 0x61, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x66, 0x61, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x5f, 0x67, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x73, 0x22, 0xb9, 0x02, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x54, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x66, 0x61, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x6d, 0x66, 0x61, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x66, 0x61, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x6d, 0x66, 0x61, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c, 0x0a,
+	0x11, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x31,
+	0x0a, 0x10, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x4f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x32, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x22, 0x7f, 0x0a, 0x1f, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x20, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x45, 0x0a, 0x0c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x49, 0x0a, 0x0e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x12, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x57, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x17, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a,
+	0x18, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x67, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x15, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22,
+	0x54, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64,
+	0x69, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x22, 0x75, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x0d,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x99, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x51, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x22, 0x52, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x22, 0x76, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x70, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c,
+	0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a,
+	0x19, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x17, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x18, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x16, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x0e, 0x53, 0x6f, 0x44,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x10, 0x53, 0x6f, 0x44, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x22,
+	0x7b, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x66, 0x61, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x66, 0x61, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x12,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74,
+	0x70, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x58, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x66, 0x61, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x66, 0x61, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x52, 0x0a, 0x12, 0x4d, 0x46, 0x41, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x15, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x77, 0x0a, 0x16, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64,
+	0x22, 0x58, 0x0a, 0x1c, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x75, 0x0a, 0x11, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x6a, 0x0a, 0x1b, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x0f, 0x0a,
+	0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x43,
+	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x45,
+	0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x47,
+	0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x53, 0x10, 0x05, 0x2a, 0x31, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x54, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32, 0xfb, 0x31, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x4e,
+	0x5a, 0x12, 0x64, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x2a,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64,
+	0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x91, 0x01,
+	0x0a, 0x18, 0x61, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x37, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7d, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x08, 0x67,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x14,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x16, 0x6c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x6c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54,
+	0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x38, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6a, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x11,
+	0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x75, 0x6e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x67, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x53, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74,
+	0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75,
+	0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x59, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5a, 0x0a, 0x09,
+	0x67, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x59, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x64, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x47, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
+	0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x53,
+	0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f,
+	0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a,
+	0x0c, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x44,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x6f, 0x44, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x6f, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x70, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x11, 0x64, 0x65, 0x6e,
+	0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x19, 0x6c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73,
+	0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x2b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50,
+	0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4d, 0x46, 0x41, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x72,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4d, 0x46, 0x41, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x2b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x6b, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x7a, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x6f, 0x0a, 0x17, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c,
+	0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64,
+	0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x64, 0x6c, 0x73, 0x68, 0x6c,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x6c, 0x73, 0x68, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x7a,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_authnz_proto_rawDescOnce sync.Once
+	file_proto_authnz_proto_rawDescData = file_proto_authnz_proto_rawDesc
+)
+
+func file_proto_authnz_proto_rawDescGZIP() []byte {
+	file_proto_authnz_proto_rawDescOnce.Do(func() {
+		file_proto_authnz_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_authnz_proto_rawDescData)
+	})
+	return file_proto_authnz_proto_rawDescData
+}
+
+var file_proto_authnz_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_authnz_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_proto_authnz_proto_goTypes = []interface{}{
+	(Operation)(0),                            // 0: com.github.dlshle.authnz.Operation
+	(Verdict)(0),                              // 1: com.github.dlshle.authnz.Verdict
+	(SoDRule_Kind)(0),                         // 2: com.github.dlshle.authnz.SoDRule.Kind
+	(AccessRequest_State)(0),                  // 3: com.github.dlshle.authnz.AccessRequest.State
+	(RelationTree_Operation)(0),               // 4: com.github.dlshle.authnz.RelationTree.Operation
+	(*Subject)(nil),                           // 5: com.github.dlshle.authnz.Subject
+	(*Group)(nil),                             // 6: com.github.dlshle.authnz.Group
+	(*Attribute)(nil),                         // 7: com.github.dlshle.authnz.Attribute
+	(*Contract)(nil),                          // 8: com.github.dlshle.authnz.Contract
+	(*BreakGlass)(nil),                        // 9: com.github.dlshle.authnz.BreakGlass
+	(*Policy)(nil),                            // 10: com.github.dlshle.authnz.Policy
+	(*PolicyTarget)(nil),                      // 11: com.github.dlshle.authnz.PolicyTarget
+	(*Resource)(nil),                          // 12: com.github.dlshle.authnz.Resource
+	(*Role)(nil),                              // 13: com.github.dlshle.authnz.Role
+	(*SoDRule)(nil),                           // 14: com.github.dlshle.authnz.SoDRule
+	(*SoDGroup)(nil),                          // 15: com.github.dlshle.authnz.SoDGroup
+	(*AccessRequest)(nil),                     // 16: com.github.dlshle.authnz.AccessRequest
+	(*RelationObject)(nil),                    // 17: com.github.dlshle.authnz.RelationObject
+	(*SubjectSet)(nil),                        // 18: com.github.dlshle.authnz.SubjectSet
+	(*RelationTuple)(nil),                     // 19: com.github.dlshle.authnz.RelationTuple
+	(*RelationTree)(nil),                      // 20: com.github.dlshle.authnz.RelationTree
+	(*PolicyCondition)(nil),                   // 21: com.github.dlshle.authnz.PolicyCondition
+	(*HasAttributesCondition)(nil),            // 22: com.github.dlshle.authnz.HasAttributesCondition
+	(*EvaluateOPCondition)(nil),               // 23: com.github.dlshle.authnz.EvaluateOPCondition
+	(*ContextInGroupAttributesCondition)(nil), // 24: com.github.dlshle.authnz.ContextInGroupAttributesCondition
+	(*ContextInLiteralSetCondition)(nil),      // 25: com.github.dlshle.authnz.ContextInLiteralSetCondition
+	(*ContextInGroupAttributesInLiteralSetCondition)(nil), // 26: com.github.dlshle.authnz.ContextInGroupAttributesInLiteralSetCondition
+	(*ResourceAttributeCondition)(nil),                    // 27: com.github.dlshle.authnz.ResourceAttributeCondition
+	(*ResourceMatchesGroupAttributeCondition)(nil),        // 28: com.github.dlshle.authnz.ResourceMatchesGroupAttributeCondition
+	(*NegationCondition)(nil),                             // 29: com.github.dlshle.authnz.NegationCondition
+	(*OrCondition)(nil),                                   // 30: com.github.dlshle.authnz.OrCondition
+	(*AndCondition)(nil),                                  // 31: com.github.dlshle.authnz.AndCondition
+	(*MFAAuthenticatedCondition)(nil),                     // 32: com.github.dlshle.authnz.MFAAuthenticatedCondition
+	(*ContextProperty)(nil),                               // 33: com.github.dlshle.authnz.ContextProperty
+	(*AuthorizeRequest)(nil),                              // 34: com.github.dlshle.authnz.AuthorizeRequest
+	(*AuthorizeResponse)(nil),                             // 35: com.github.dlshle.authnz.AuthorizeResponse
+	(*AuthContext)(nil),                                   // 36: com.github.dlshle.authnz.AuthContext
+	(*AddSubjectRequest)(nil),                             // 37: com.github.dlshle.authnz.AddSubjectRequest
+	(*AddSubjectResponse)(nil),                            // 38: com.github.dlshle.authnz.AddSubjectResponse
+	(*SubjectIDRequest)(nil),                              // 39: com.github.dlshle.authnz.SubjectIDRequest
+	(*SetPasswordRequest)(nil),                            // 40: com.github.dlshle.authnz.SetPasswordRequest
+	(*SubjectsByUserIDRequest)(nil),                       // 41: com.github.dlshle.authnz.SubjectsByUserIDRequest
+	(*SubjectsByUserIDResponse)(nil),                      // 42: com.github.dlshle.authnz.SubjectsByUserIDResponse
+	(*AddSubjectWithAttributesRequest)(nil),               // 43: com.github.dlshle.authnz.AddSubjectWithAttributesRequest
+	(*AddSubjectWithAttributesResponse)(nil),              // 44: com.github.dlshle.authnz.AddSubjectWithAttributesResponse
+	(*GroupRequest)(nil),                                  // 45: com.github.dlshle.authnz.GroupRequest
+	(*GroupResponse)(nil),                                 // 46: com.github.dlshle.authnz.GroupResponse
+	(*GroupsResponse)(nil),                                // 47: com.github.dlshle.authnz.GroupsResponse
+	(*ListGroupsByAttributesRequest)(nil),                 // 48: com.github.dlshle.authnz.ListGroupsByAttributesRequest
+	(*GroupByIDRequest)(nil),                              // 49: com.github.dlshle.authnz.GroupByIDRequest
+	(*GroupParentRequest)(nil),                            // 50: com.github.dlshle.authnz.GroupParentRequest
+	(*GroupRoleRequest)(nil),                              // 51: com.github.dlshle.authnz.GroupRoleRequest
+	(*RoleRequest)(nil),                                   // 52: com.github.dlshle.authnz.RoleRequest
+	(*RoleByIDRequest)(nil),                               // 53: com.github.dlshle.authnz.RoleByIDRequest
+	(*CheckPermissionRequest)(nil),                        // 54: com.github.dlshle.authnz.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),                       // 55: com.github.dlshle.authnz.CheckPermissionResponse
+	(*WriteRelationTuplesRequest)(nil),                    // 56: com.github.dlshle.authnz.WriteRelationTuplesRequest
+	(*CheckRelationRequest)(nil),                          // 57: com.github.dlshle.authnz.CheckRelationRequest
+	(*CheckRelationResponse)(nil),                         // 58: com.github.dlshle.authnz.CheckRelationResponse
+	(*ExpandRelationRequest)(nil),                         // 59: com.github.dlshle.authnz.ExpandRelationRequest
+	(*PolicyRequest)(nil),                                 // 60: com.github.dlshle.authnz.PolicyRequest
+	(*PolicyByIDRequest)(nil),                             // 61: com.github.dlshle.authnz.PolicyByIDRequest
+	(*CreateGroupForSubjectsRequest)(nil),                 // 62: com.github.dlshle.authnz.CreateGroupForSubjectsRequest
+	(*CreateGroupForSubjectsResponse)(nil),                // 63: com.github.dlshle.authnz.CreateGroupForSubjectsResponse
+	(*ContractRequest)(nil),                               // 64: com.github.dlshle.authnz.ContractRequest
+	(*ContractResponse)(nil),                              // 65: com.github.dlshle.authnz.ContractResponse
+	(*ContractWindowRequest)(nil),                         // 66: com.github.dlshle.authnz.ContractWindowRequest
+	(*ListGroupMembersRequest)(nil),                       // 67: com.github.dlshle.authnz.ListGroupMembersRequest
+	(*GroupMembersResponse)(nil),                          // 68: com.github.dlshle.authnz.GroupMembersResponse
+	(*AddSubjectsToGroupRequest)(nil),                     // 69: com.github.dlshle.authnz.AddSubjectsToGroupRequest
+	(*RemoveSubjectsFromGroupRequest)(nil),                // 70: com.github.dlshle.authnz.RemoveSubjectsFromGroupRequest
+	(*SubjectMembershipResult)(nil),                       // 71: com.github.dlshle.authnz.SubjectMembershipResult
+	(*GroupMembershipResponse)(nil),                       // 72: com.github.dlshle.authnz.GroupMembershipResponse
+	(*RequestAccessRequest)(nil),                          // 73: com.github.dlshle.authnz.RequestAccessRequest
+	(*AccessRequestByIDRequest)(nil),                      // 74: com.github.dlshle.authnz.AccessRequestByIDRequest
+	(*AccessDecisionRequest)(nil),                         // 75: com.github.dlshle.authnz.AccessDecisionRequest
+	(*ListPendingAccessRequestsRequest)(nil),              // 76: com.github.dlshle.authnz.ListPendingAccessRequestsRequest
+	(*AccessRequestsResponse)(nil),                        // 77: com.github.dlshle.authnz.AccessRequestsResponse
+	(*BreakGlassRequest)(nil),                             // 78: com.github.dlshle.authnz.BreakGlassRequest
+	(*SoDRuleRequest)(nil),                                // 79: com.github.dlshle.authnz.SoDRuleRequest
+	(*SoDRuleByIDRequest)(nil),                            // 80: com.github.dlshle.authnz.SoDRuleByIDRequest
+	(*ListSoDRulesRequest)(nil),                           // 81: com.github.dlshle.authnz.ListSoDRulesRequest
+	(*SoDRulesResponse)(nil),                              // 82: com.github.dlshle.authnz.SoDRulesResponse
+	(*DeleteContractRequest)(nil),                         // 83: com.github.dlshle.authnz.DeleteContractRequest
+	(*EnrollTOTPRequest)(nil),                             // 84: com.github.dlshle.authnz.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),                            // 85: com.github.dlshle.authnz.EnrollTOTPResponse
+	(*VerifyTOTPRequest)(nil),                             // 86: com.github.dlshle.authnz.VerifyTOTPRequest
+	(*DeleteTOTPRequest)(nil),                             // 87: com.github.dlshle.authnz.DeleteTOTPRequest
+	(*RecoveryCodeRequest)(nil),                           // 88: com.github.dlshle.authnz.RecoveryCodeRequest
+	(*MFASessionResponse)(nil),                            // 89: com.github.dlshle.authnz.MFASessionResponse
+	(*FederatedLoginRequest)(nil),                         // 90: com.github.dlshle.authnz.FederatedLoginRequest
+	(*FederatedLoginResponse)(nil),                        // 91: com.github.dlshle.authnz.FederatedLoginResponse
+	(*LinkFederatedIdentityRequest)(nil),                  // 92: com.github.dlshle.authnz.LinkFederatedIdentityRequest
+	(*FederatedIdentity)(nil),                             // 93: com.github.dlshle.authnz.FederatedIdentity
+	(*FederatedIdentitiesResponse)(nil),                   // 94: com.github.dlshle.authnz.FederatedIdentitiesResponse
+	(*EmptyResponse)(nil),                                 // 95: com.github.dlshle.authnz.EmptyResponse
+}
+var file_proto_authnz_proto_depIdxs = []int32{
+	7,   // 0: com.github.dlshle.authnz.Group.attributes:type_name -> com.github.dlshle.authnz.Attribute
+	9,   // 1: com.github.dlshle.authnz.Contract.break_glass:type_name -> com.github.dlshle.authnz.BreakGlass
+	21,  // 2: com.github.dlshle.authnz.Policy.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
+	11,  // 3: com.github.dlshle.authnz.Policy.target:type_name -> com.github.dlshle.authnz.PolicyTarget
+	7,   // 4: com.github.dlshle.authnz.Resource.attributes:type_name -> com.github.dlshle.authnz.Attribute
+	15,  // 5: com.github.dlshle.authnz.SoDRule.groups:type_name -> com.github.dlshle.authnz.SoDGroup
+	2,   // 6: com.github.dlshle.authnz.SoDRule.kind:type_name -> com.github.dlshle.authnz.SoDRule.Kind
+	7,   // 7: com.github.dlshle.authnz.SoDGroup.attribute:type_name -> com.github.dlshle.authnz.Attribute
+	3,   // 8: com.github.dlshle.authnz.AccessRequest.state:type_name -> com.github.dlshle.authnz.AccessRequest.State
+	17,  // 9: com.github.dlshle.authnz.SubjectSet.object:type_name -> com.github.dlshle.authnz.RelationObject
+	17,  // 10: com.github.dlshle.authnz.RelationTuple.object:type_name -> com.github.dlshle.authnz.RelationObject
+	18,  // 11: com.github.dlshle.authnz.RelationTuple.subject_set:type_name -> com.github.dlshle.authnz.SubjectSet
+	4,   // 12: com.github.dlshle.authnz.RelationTree.operation:type_name -> com.github.dlshle.authnz.RelationTree.Operation
+	18,  // 13: com.github.dlshle.authnz.RelationTree.userset:type_name -> com.github.dlshle.authnz.SubjectSet
+	20,  // 14: com.github.dlshle.authnz.RelationTree.children:type_name -> com.github.dlshle.authnz.RelationTree
+	18,  // 15: com.github.dlshle.authnz.RelationTree.subject_sets:type_name -> com.github.dlshle.authnz.SubjectSet
+	22,  // 16: com.github.dlshle.authnz.PolicyCondition.has_attribute:type_name -> com.github.dlshle.authnz.HasAttributesCondition
+	23,  // 17: com.github.dlshle.authnz.PolicyCondition.evaluate_attribute:type_name -> com.github.dlshle.authnz.EvaluateOPCondition
+	24,  // 18: com.github.dlshle.authnz.PolicyCondition.context_in_group_attributes:type_name -> com.github.dlshle.authnz.ContextInGroupAttributesCondition
+	25,  // 19: com.github.dlshle.authnz.PolicyCondition.context_in_literal_set:type_name -> com.github.dlshle.authnz.ContextInLiteralSetCondition
+	26,  // 20: com.github.dlshle.authnz.PolicyCondition.attribute_in_literal_set:type_name -> com.github.dlshle.authnz.ContextInGroupAttributesInLiteralSetCondition
+	29,  // 21: com.github.dlshle.authnz.PolicyCondition.negation:type_name -> com.github.dlshle.authnz.NegationCondition
+	30,  // 22: com.github.dlshle.authnz.PolicyCondition.or:type_name -> com.github.dlshle.authnz.OrCondition
+	31,  // 23: com.github.dlshle.authnz.PolicyCondition.and:type_name -> com.github.dlshle.authnz.AndCondition
+	32,  // 24: com.github.dlshle.authnz.PolicyCondition.mfa_authenticated:type_name -> com.github.dlshle.authnz.MFAAuthenticatedCondition
+	27,  // 25: com.github.dlshle.authnz.PolicyCondition.resource_attribute:type_name -> com.github.dlshle.authnz.ResourceAttributeCondition
+	28,  // 26: com.github.dlshle.authnz.PolicyCondition.resource_matches_group_attribute:type_name -> com.github.dlshle.authnz.ResourceMatchesGroupAttributeCondition
+	0,   // 27: com.github.dlshle.authnz.EvaluateOPCondition.op:type_name -> com.github.dlshle.authnz.Operation
+	0,   // 28: com.github.dlshle.authnz.ResourceAttributeCondition.op:type_name -> com.github.dlshle.authnz.Operation
+	0,   // 29: com.github.dlshle.authnz.ResourceMatchesGroupAttributeCondition.op:type_name -> com.github.dlshle.authnz.Operation
+	21,  // 30: com.github.dlshle.authnz.NegationCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
+	21,  // 31: com.github.dlshle.authnz.OrCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
+	21,  // 32: com.github.dlshle.authnz.AndCondition.condition:type_name -> com.github.dlshle.authnz.PolicyCondition
+	33,  // 33: com.github.dlshle.authnz.AuthorizeRequest.context_property:type_name -> com.github.dlshle.authnz.ContextProperty
+	12,  // 34: com.github.dlshle.authnz.AuthorizeRequest.resource:type_name -> com.github.dlshle.authnz.Resource
+	1,   // 35: com.github.dlshle.authnz.AuthorizeResponse.verdict:type_name -> com.github.dlshle.authnz.Verdict
+	33,  // 36: com.github.dlshle.authnz.AuthContext.context_property:type_name -> com.github.dlshle.authnz.ContextProperty
+	12,  // 37: com.github.dlshle.authnz.AuthContext.resource:type_name -> com.github.dlshle.authnz.Resource
+	5,   // 38: com.github.dlshle.authnz.AddSubjectResponse.subject:type_name -> com.github.dlshle.authnz.Subject
+	5,   // 39: com.github.dlshle.authnz.SubjectsByUserIDResponse.subjects:type_name -> com.github.dlshle.authnz.Subject
+	7,   // 40: com.github.dlshle.authnz.AddSubjectWithAttributesRequest.attributes:type_name -> com.github.dlshle.authnz.Attribute
+	5,   // 41: com.github.dlshle.authnz.AddSubjectWithAttributesResponse.subject:type_name -> com.github.dlshle.authnz.Subject
+	6,   // 42: com.github.dlshle.authnz.AddSubjectWithAttributesResponse.group:type_name -> com.github.dlshle.authnz.Group
+	6,   // 43: com.github.dlshle.authnz.GroupRequest.group:type_name -> com.github.dlshle.authnz.Group
+	6,   // 44: com.github.dlshle.authnz.GroupResponse.group:type_name -> com.github.dlshle.authnz.Group
+	6,   // 45: com.github.dlshle.authnz.GroupsResponse.groups:type_name -> com.github.dlshle.authnz.Group
+	7,   // 46: com.github.dlshle.authnz.ListGroupsByAttributesRequest.attributes:type_name -> com.github.dlshle.authnz.Attribute
+	13,  // 47: com.github.dlshle.authnz.RoleRequest.role:type_name -> com.github.dlshle.authnz.Role
+	1,   // 48: com.github.dlshle.authnz.CheckPermissionResponse.verdict:type_name -> com.github.dlshle.authnz.Verdict
+	19,  // 49: com.github.dlshle.authnz.WriteRelationTuplesRequest.writes:type_name -> com.github.dlshle.authnz.RelationTuple
+	19,  // 50: com.github.dlshle.authnz.WriteRelationTuplesRequest.deletes:type_name -> com.github.dlshle.authnz.RelationTuple
+	17,  // 51: com.github.dlshle.authnz.CheckRelationRequest.object:type_name -> com.github.dlshle.authnz.RelationObject
+	1,   // 52: com.github.dlshle.authnz.CheckRelationResponse.verdict:type_name -> com.github.dlshle.authnz.Verdict
+	17,  // 53: com.github.dlshle.authnz.ExpandRelationRequest.object:type_name -> com.github.dlshle.authnz.RelationObject
+	10,  // 54: com.github.dlshle.authnz.PolicyRequest.policy:type_name -> com.github.dlshle.authnz.Policy
+	7,   // 55: com.github.dlshle.authnz.CreateGroupForSubjectsRequest.attributes:type_name -> com.github.dlshle.authnz.Attribute
+	8,   // 56: com.github.dlshle.authnz.CreateGroupForSubjectsResponse.contracts:type_name -> com.github.dlshle.authnz.Contract
+	6,   // 57: com.github.dlshle.authnz.CreateGroupForSubjectsResponse.group:type_name -> com.github.dlshle.authnz.Group
+	8,   // 58: com.github.dlshle.authnz.ContractRequest.contract:type_name -> com.github.dlshle.authnz.Contract
+	8,   // 59: com.github.dlshle.authnz.ContractResponse.contract:type_name -> com.github.dlshle.authnz.Contract
+	8,   // 60: com.github.dlshle.authnz.GroupMembersResponse.members:type_name -> com.github.dlshle.authnz.Contract
+	8,   // 61: com.github.dlshle.authnz.SubjectMembershipResult.contract:type_name -> com.github.dlshle.authnz.Contract
+	71,  // 62: com.github.dlshle.authnz.GroupMembershipResponse.results:type_name -> com.github.dlshle.authnz.SubjectMembershipResult
+	16,  // 63: com.github.dlshle.authnz.AccessRequestsResponse.requests:type_name -> com.github.dlshle.authnz.AccessRequest
+	14,  // 64: com.github.dlshle.authnz.SoDRuleRequest.rule:type_name -> com.github.dlshle.authnz.SoDRule
+	14,  // 65: com.github.dlshle.authnz.SoDRulesResponse.rules:type_name -> com.github.dlshle.authnz.SoDRule
+	5,   // 66: com.github.dlshle.authnz.FederatedLoginResponse.subject:type_name -> com.github.dlshle.authnz.Subject
+	93,  // 67: com.github.dlshle.authnz.FederatedIdentitiesResponse.identities:type_name -> com.github.dlshle.authnz.FederatedIdentity
+	34,  // 68: com.github.dlshle.authnz.AuthNZ.authorize:input_type -> com.github.dlshle.authnz.AuthorizeRequest
+	37,  // 69: com.github.dlshle.authnz.AuthNZ.addSubject:input_type -> com.github.dlshle.authnz.AddSubjectRequest
+	39,  // 70: com.github.dlshle.authnz.AuthNZ.getSubject:input_type -> com.github.dlshle.authnz.SubjectIDRequest
+	43,  // 71: com.github.dlshle.authnz.AuthNZ.addSubjectWithAttributes:input_type -> com.github.dlshle.authnz.AddSubjectWithAttributesRequest
+	62,  // 72: com.github.dlshle.authnz.AuthNZ.createGroupsForSubjects:input_type -> com.github.dlshle.authnz.CreateGroupForSubjectsRequest
+	41,  // 73: com.github.dlshle.authnz.AuthNZ.findSubjectsByUserID:input_type -> com.github.dlshle.authnz.SubjectsByUserIDRequest
+	39,  // 74: com.github.dlshle.authnz.AuthNZ.deleteSubject:input_type -> com.github.dlshle.authnz.SubjectIDRequest
+	40,  // 75: com.github.dlshle.authnz.AuthNZ.setPassword:input_type -> com.github.dlshle.authnz.SetPasswordRequest
+	45,  // 76: com.github.dlshle.authnz.AuthNZ.createGroup:input_type -> com.github.dlshle.authnz.GroupRequest
+	49,  // 77: com.github.dlshle.authnz.AuthNZ.getGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
+	39,  // 78: com.github.dlshle.authnz.AuthNZ.getGroupsBySubjectID:input_type -> com.github.dlshle.authnz.SubjectIDRequest
+	48,  // 79: com.github.dlshle.authnz.AuthNZ.listGroupsByAttributes:input_type -> com.github.dlshle.authnz.ListGroupsByAttributesRequest
+	45,  // 80: com.github.dlshle.authnz.AuthNZ.updateGroup:input_type -> com.github.dlshle.authnz.GroupRequest
+	49,  // 81: com.github.dlshle.authnz.AuthNZ.deleteGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
+	67,  // 82: com.github.dlshle.authnz.AuthNZ.listGroupMembers:input_type -> com.github.dlshle.authnz.ListGroupMembersRequest
+	69,  // 83: com.github.dlshle.authnz.AuthNZ.addSubjectsToGroup:input_type -> com.github.dlshle.authnz.AddSubjectsToGroupRequest
+	70,  // 84: com.github.dlshle.authnz.AuthNZ.removeSubjectsFromGroup:input_type -> com.github.dlshle.authnz.RemoveSubjectsFromGroupRequest
+	49,  // 85: com.github.dlshle.authnz.AuthNZ.duplicateGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
+	50,  // 86: com.github.dlshle.authnz.AuthNZ.attachParentGroup:input_type -> com.github.dlshle.authnz.GroupParentRequest
+	50,  // 87: com.github.dlshle.authnz.AuthNZ.detachParentGroup:input_type -> com.github.dlshle.authnz.GroupParentRequest
+	49,  // 88: com.github.dlshle.authnz.AuthNZ.getEffectiveGroup:input_type -> com.github.dlshle.authnz.GroupByIDRequest
+	51,  // 89: com.github.dlshle.authnz.AuthNZ.assignGroupRole:input_type -> com.github.dlshle.authnz.GroupRoleRequest
+	51,  // 90: com.github.dlshle.authnz.AuthNZ.unassignGroupRole:input_type -> com.github.dlshle.authnz.GroupRoleRequest
+	52,  // 91: com.github.dlshle.authnz.AuthNZ.createRole:input_type -> com.github.dlshle.authnz.RoleRequest
+	53,  // 92: com.github.dlshle.authnz.AuthNZ.getRole:input_type -> com.github.dlshle.authnz.RoleByIDRequest
+	52,  // 93: com.github.dlshle.authnz.AuthNZ.updateRole:input_type -> com.github.dlshle.authnz.RoleRequest
+	53,  // 94: com.github.dlshle.authnz.AuthNZ.deleteRole:input_type -> com.github.dlshle.authnz.RoleByIDRequest
+	54,  // 95: com.github.dlshle.authnz.AuthNZ.checkPermission:input_type -> com.github.dlshle.authnz.CheckPermissionRequest
+	56,  // 96: com.github.dlshle.authnz.AuthNZ.writeRelationTuples:input_type -> com.github.dlshle.authnz.WriteRelationTuplesRequest
+	57,  // 97: com.github.dlshle.authnz.AuthNZ.checkRelation:input_type -> com.github.dlshle.authnz.CheckRelationRequest
+	59,  // 98: com.github.dlshle.authnz.AuthNZ.expandRelation:input_type -> com.github.dlshle.authnz.ExpandRelationRequest
+	60,  // 99: com.github.dlshle.authnz.AuthNZ.createPolicy:input_type -> com.github.dlshle.authnz.PolicyRequest
+	61,  // 100: com.github.dlshle.authnz.AuthNZ.getPolicy:input_type -> com.github.dlshle.authnz.PolicyByIDRequest
+	60,  // 101: com.github.dlshle.authnz.AuthNZ.updatePolicy:input_type -> com.github.dlshle.authnz.PolicyRequest
+	61,  // 102: com.github.dlshle.authnz.AuthNZ.deletePolicy:input_type -> com.github.dlshle.authnz.PolicyByIDRequest
+	64,  // 103: com.github.dlshle.authnz.AuthNZ.createContract:input_type -> com.github.dlshle.authnz.ContractRequest
+	66,  // 104: com.github.dlshle.authnz.AuthNZ.updateContractWindow:input_type -> com.github.dlshle.authnz.ContractWindowRequest
+	78,  // 105: com.github.dlshle.authnz.AuthNZ.breakGlass:input_type -> com.github.dlshle.authnz.BreakGlassRequest
+	83,  // 106: com.github.dlshle.authnz.AuthNZ.deleteContract:input_type -> com.github.dlshle.authnz.DeleteContractRequest
+	79,  // 107: com.github.dlshle.authnz.AuthNZ.createSoDRule:input_type -> com.github.dlshle.authnz.SoDRuleRequest
+	80,  // 108: com.github.dlshle.authnz.AuthNZ.getSoDRule:input_type -> com.github.dlshle.authnz.SoDRuleByIDRequest
+	79,  // 109: com.github.dlshle.authnz.AuthNZ.updateSoDRule:input_type -> com.github.dlshle.authnz.SoDRuleRequest
+	80,  // 110: com.github.dlshle.authnz.AuthNZ.deleteSoDRule:input_type -> com.github.dlshle.authnz.SoDRuleByIDRequest
+	81,  // 111: com.github.dlshle.authnz.AuthNZ.listSoDRules:input_type -> com.github.dlshle.authnz.ListSoDRulesRequest
+	73,  // 112: com.github.dlshle.authnz.AuthNZ.requestAccess:input_type -> com.github.dlshle.authnz.RequestAccessRequest
+	74,  // 113: com.github.dlshle.authnz.AuthNZ.getAccessRequest:input_type -> com.github.dlshle.authnz.AccessRequestByIDRequest
+	74,  // 114: com.github.dlshle.authnz.AuthNZ.cancelAccessRequest:input_type -> com.github.dlshle.authnz.AccessRequestByIDRequest
+	75,  // 115: com.github.dlshle.authnz.AuthNZ.approveAccessRequest:input_type -> com.github.dlshle.authnz.AccessDecisionRequest
+	75,  // 116: com.github.dlshle.authnz.AuthNZ.denyAccessRequest:input_type -> com.github.dlshle.authnz.AccessDecisionRequest
+	76,  // 117: com.github.dlshle.authnz.AuthNZ.listPendingAccessRequests:input_type -> com.github.dlshle.authnz.ListPendingAccessRequestsRequest
+	84,  // 118: com.github.dlshle.authnz.AuthNZ.enrollTOTP:input_type -> com.github.dlshle.authnz.EnrollTOTPRequest
+	86,  // 119: com.github.dlshle.authnz.AuthNZ.confirmTOTP:input_type -> com.github.dlshle.authnz.VerifyTOTPRequest
+	86,  // 120: com.github.dlshle.authnz.AuthNZ.verifyTOTP:input_type -> com.github.dlshle.authnz.VerifyTOTPRequest
+	88,  // 121: com.github.dlshle.authnz.AuthNZ.redeemRecoveryCode:input_type -> com.github.dlshle.authnz.RecoveryCodeRequest
+	87,  // 122: com.github.dlshle.authnz.AuthNZ.deleteTOTP:input_type -> com.github.dlshle.authnz.DeleteTOTPRequest
+	90,  // 123: com.github.dlshle.authnz.AuthNZ.federatedLogin:input_type -> com.github.dlshle.authnz.FederatedLoginRequest
+	92,  // 124: com.github.dlshle.authnz.AuthNZ.linkFederatedIdentity:input_type -> com.github.dlshle.authnz.LinkFederatedIdentityRequest
+	93,  // 125: com.github.dlshle.authnz.AuthNZ.unlinkFederatedIdentity:input_type -> com.github.dlshle.authnz.FederatedIdentity
+	39,  // 126: com.github.dlshle.authnz.AuthNZ.listFederatedIdentities:input_type -> com.github.dlshle.authnz.SubjectIDRequest
+	35,  // 127: com.github.dlshle.authnz.AuthNZ.authorize:output_type -> com.github.dlshle.authnz.AuthorizeResponse
+	38,  // 128: com.github.dlshle.authnz.AuthNZ.addSubject:output_type -> com.github.dlshle.authnz.AddSubjectResponse
+	5,   // 129: com.github.dlshle.authnz.AuthNZ.getSubject:output_type -> com.github.dlshle.authnz.Subject
+	44,  // 130: com.github.dlshle.authnz.AuthNZ.addSubjectWithAttributes:output_type -> com.github.dlshle.authnz.AddSubjectWithAttributesResponse
+	63,  // 131: com.github.dlshle.authnz.AuthNZ.createGroupsForSubjects:output_type -> com.github.dlshle.authnz.CreateGroupForSubjectsResponse
+	42,  // 132: com.github.dlshle.authnz.AuthNZ.findSubjectsByUserID:output_type -> com.github.dlshle.authnz.SubjectsByUserIDResponse
+	95,  // 133: com.github.dlshle.authnz.AuthNZ.deleteSubject:output_type -> com.github.dlshle.authnz.EmptyResponse
+	95,  // 134: com.github.dlshle.authnz.AuthNZ.setPassword:output_type -> com.github.dlshle.authnz.EmptyResponse
+	46,  // 135: com.github.dlshle.authnz.AuthNZ.createGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 136: com.github.dlshle.authnz.AuthNZ.getGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	47,  // 137: com.github.dlshle.authnz.AuthNZ.getGroupsBySubjectID:output_type -> com.github.dlshle.authnz.GroupsResponse
+	47,  // 138: com.github.dlshle.authnz.AuthNZ.listGroupsByAttributes:output_type -> com.github.dlshle.authnz.GroupsResponse
+	46,  // 139: com.github.dlshle.authnz.AuthNZ.updateGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	95,  // 140: com.github.dlshle.authnz.AuthNZ.deleteGroup:output_type -> com.github.dlshle.authnz.EmptyResponse
+	68,  // 141: com.github.dlshle.authnz.AuthNZ.listGroupMembers:output_type -> com.github.dlshle.authnz.GroupMembersResponse
+	72,  // 142: com.github.dlshle.authnz.AuthNZ.addSubjectsToGroup:output_type -> com.github.dlshle.authnz.GroupMembershipResponse
+	72,  // 143: com.github.dlshle.authnz.AuthNZ.removeSubjectsFromGroup:output_type -> com.github.dlshle.authnz.GroupMembershipResponse
+	46,  // 144: com.github.dlshle.authnz.AuthNZ.duplicateGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 145: com.github.dlshle.authnz.AuthNZ.attachParentGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 146: com.github.dlshle.authnz.AuthNZ.detachParentGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 147: com.github.dlshle.authnz.AuthNZ.getEffectiveGroup:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 148: com.github.dlshle.authnz.AuthNZ.assignGroupRole:output_type -> com.github.dlshle.authnz.GroupResponse
+	46,  // 149: com.github.dlshle.authnz.AuthNZ.unassignGroupRole:output_type -> com.github.dlshle.authnz.GroupResponse
+	13,  // 150: com.github.dlshle.authnz.AuthNZ.createRole:output_type -> com.github.dlshle.authnz.Role
+	13,  // 151: com.github.dlshle.authnz.AuthNZ.getRole:output_type -> com.github.dlshle.authnz.Role
+	13,  // 152: com.github.dlshle.authnz.AuthNZ.updateRole:output_type -> com.github.dlshle.authnz.Role
+	95,  // 153: com.github.dlshle.authnz.AuthNZ.deleteRole:output_type -> com.github.dlshle.authnz.EmptyResponse
+	55,  // 154: com.github.dlshle.authnz.AuthNZ.checkPermission:output_type -> com.github.dlshle.authnz.CheckPermissionResponse
+	95,  // 155: com.github.dlshle.authnz.AuthNZ.writeRelationTuples:output_type -> com.github.dlshle.authnz.EmptyResponse
+	58,  // 156: com.github.dlshle.authnz.AuthNZ.checkRelation:output_type -> com.github.dlshle.authnz.CheckRelationResponse
+	20,  // 157: com.github.dlshle.authnz.AuthNZ.expandRelation:output_type -> com.github.dlshle.authnz.RelationTree
+	10,  // 158: com.github.dlshle.authnz.AuthNZ.createPolicy:output_type -> com.github.dlshle.authnz.Policy
+	10,  // 159: com.github.dlshle.authnz.AuthNZ.getPolicy:output_type -> com.github.dlshle.authnz.Policy
+	10,  // 160: com.github.dlshle.authnz.AuthNZ.updatePolicy:output_type -> com.github.dlshle.authnz.Policy
+	95,  // 161: com.github.dlshle.authnz.AuthNZ.deletePolicy:output_type -> com.github.dlshle.authnz.EmptyResponse
+	65,  // 162: com.github.dlshle.authnz.AuthNZ.createContract:output_type -> com.github.dlshle.authnz.ContractResponse
+	65,  // 163: com.github.dlshle.authnz.AuthNZ.updateContractWindow:output_type -> com.github.dlshle.authnz.ContractResponse
+	65,  // 164: com.github.dlshle.authnz.AuthNZ.breakGlass:output_type -> com.github.dlshle.authnz.ContractResponse
+	95,  // 165: com.github.dlshle.authnz.AuthNZ.deleteContract:output_type -> com.github.dlshle.authnz.EmptyResponse
+	14,  // 166: com.github.dlshle.authnz.AuthNZ.createSoDRule:output_type -> com.github.dlshle.authnz.SoDRule
+	14,  // 167: com.github.dlshle.authnz.AuthNZ.getSoDRule:output_type -> com.github.dlshle.authnz.SoDRule
+	14,  // 168: com.github.dlshle.authnz.AuthNZ.updateSoDRule:output_type -> com.github.dlshle.authnz.SoDRule
+	95,  // 169: com.github.dlshle.authnz.AuthNZ.deleteSoDRule:output_type -> com.github.dlshle.authnz.EmptyResponse
+	82,  // 170: com.github.dlshle.authnz.AuthNZ.listSoDRules:output_type -> com.github.dlshle.authnz.SoDRulesResponse
+	16,  // 171: com.github.dlshle.authnz.AuthNZ.requestAccess:output_type -> com.github.dlshle.authnz.AccessRequest
+	16,  // 172: com.github.dlshle.authnz.AuthNZ.getAccessRequest:output_type -> com.github.dlshle.authnz.AccessRequest
+	16,  // 173: com.github.dlshle.authnz.AuthNZ.cancelAccessRequest:output_type -> com.github.dlshle.authnz.AccessRequest
+	16,  // 174: com.github.dlshle.authnz.AuthNZ.approveAccessRequest:output_type -> com.github.dlshle.authnz.AccessRequest
+	16,  // 175: com.github.dlshle.authnz.AuthNZ.denyAccessRequest:output_type -> com.github.dlshle.authnz.AccessRequest
+	77,  // 176: com.github.dlshle.authnz.AuthNZ.listPendingAccessRequests:output_type -> com.github.dlshle.authnz.AccessRequestsResponse
+	85,  // 177: com.github.dlshle.authnz.AuthNZ.enrollTOTP:output_type -> com.github.dlshle.authnz.EnrollTOTPResponse
+	95,  // 178: com.github.dlshle.authnz.AuthNZ.confirmTOTP:output_type -> com.github.dlshle.authnz.EmptyResponse
+	89,  // 179: com.github.dlshle.authnz.AuthNZ.verifyTOTP:output_type -> com.github.dlshle.authnz.MFASessionResponse
+	89,  // 180: com.github.dlshle.authnz.AuthNZ.redeemRecoveryCode:output_type -> com.github.dlshle.authnz.MFASessionResponse
+	95,  // 181: com.github.dlshle.authnz.AuthNZ.deleteTOTP:output_type -> com.github.dlshle.authnz.EmptyResponse
+	91,  // 182: com.github.dlshle.authnz.AuthNZ.federatedLogin:output_type -> com.github.dlshle.authnz.FederatedLoginResponse
+	93,  // 183: com.github.dlshle.authnz.AuthNZ.linkFederatedIdentity:output_type -> com.github.dlshle.authnz.FederatedIdentity
+	95,  // 184: com.github.dlshle.authnz.AuthNZ.unlinkFederatedIdentity:output_type -> com.github.dlshle.authnz.EmptyResponse
+	94,  // 185: com.github.dlshle.authnz.AuthNZ.listFederatedIdentities:output_type -> com.github.dlshle.authnz.FederatedIdentitiesResponse
+	127, // [127:186] is the sub-list for method output_type
+	68,  // [68:127] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
+}
+
+func init() { file_proto_authnz_proto_init() }
+func file_proto_authnz_proto_init() {
+	if File_proto_authnz_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_authnz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakGlass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasAttributesCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateOPCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextInGroupAttributesCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextInLiteralSetCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextInGroupAttributesInLiteralSetCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceAttributeCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceMatchesGroupAttributeCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NegationCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AndCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFAAuthenticatedCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectsByUserIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectsByUserIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2694,8 +7635,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_proto_authnz_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubjectWithAttributesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2706,8 +7647,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attribute); i {
+		file_proto_authnz_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubjectWithAttributesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,8 +7659,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Contract); i {
+		file_proto_authnz_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,8 +7671,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Policy); i {
+		file_proto_authnz_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,8 +7683,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyCondition); i {
+		file_proto_authnz_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,8 +7695,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasAttributesCondition); i {
+		file_proto_authnz_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsByAttributesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,8 +7707,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EvaluateOPCondition); i {
+		file_proto_authnz_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,8 +7719,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContextInGroupAttributesCondition); i {
+		file_proto_authnz_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupParentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,8 +7731,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContextInLiteralSetCondition); i {
+		file_proto_authnz_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,8 +7743,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContextInGroupAttributesInLiteralSetCondition); i {
+		file_proto_authnz_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,8 +7755,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NegationCondition); i {
+		file_proto_authnz_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2826,8 +7767,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrCondition); i {
+		file_proto_authnz_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2838,8 +7779,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AndCondition); i {
+		file_proto_authnz_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2850,8 +7791,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContextProperty); i {
+		file_proto_authnz_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteRelationTuplesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2862,8 +7803,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeRequest); i {
+		file_proto_authnz_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRelationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2874,8 +7815,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeResponse); i {
+		file_proto_authnz_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRelationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2886,8 +7827,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthContext); i {
+		file_proto_authnz_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpandRelationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2898,8 +7839,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSubjectRequest); i {
+		file_proto_authnz_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2910,8 +7851,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSubjectResponse); i {
+		file_proto_authnz_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2922,8 +7863,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubjectIDRequest); i {
+		file_proto_authnz_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupForSubjectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2934,8 +7875,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubjectsByUserIDRequest); i {
+		file_proto_authnz_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupForSubjectsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2946,8 +7887,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubjectsByUserIDResponse); i {
+		file_proto_authnz_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2958,8 +7899,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSubjectWithAttributesRequest); i {
+		file_proto_authnz_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2970,8 +7911,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSubjectWithAttributesResponse); i {
+		file_proto_authnz_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2982,8 +7923,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRequest); i {
+		file_proto_authnz_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2994,8 +7935,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupResponse); i {
+		file_proto_authnz_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3006,8 +7947,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupsResponse); i {
+		file_proto_authnz_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubjectsToGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3018,8 +7959,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupByIDRequest); i {
+		file_proto_authnz_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSubjectsFromGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3030,8 +7971,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyRequest); i {
+		file_proto_authnz_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubjectMembershipResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3042,8 +7983,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyByIDRequest); i {
+		file_proto_authnz_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMembershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3054,8 +7995,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupForSubjectsRequest); i {
+		file_proto_authnz_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestAccessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,8 +8007,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupForSubjectsResponse); i {
+		file_proto_authnz_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRequestByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,8 +8019,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractRequest); i {
+		file_proto_authnz_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessDecisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,8 +8031,8 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractResponse); i {
+		file_proto_authnz_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingAccessRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,7 +8043,79 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_authnz_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakGlassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDRuleByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSoDRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteContractRequest); i {
 			case 0:
 				return &v.state
@@ -3114,7 +8127,139 @@ func file_proto_authnz_proto_init() {
 				return nil
 			}
 		}
-		file_proto_authnz_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_authnz_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTOTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTOTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFASessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkFederatedIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedIdentitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_authnz_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyResponse); i {
 			case 0:
 				return &v.state
@@ -3127,7 +8272,7 @@ func file_proto_authnz_proto_init() {
 			}
 		}
 	}
-	file_proto_authnz_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_proto_authnz_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*PolicyCondition_HasAttribute)(nil),
 		(*PolicyCondition_EvaluateAttribute)(nil),
 		(*PolicyCondition_ContextInGroupAttributes)(nil),
@@ -3136,14 +8281,17 @@ func file_proto_authnz_proto_init() {
 		(*PolicyCondition_Negation)(nil),
 		(*PolicyCondition_Or)(nil),
 		(*PolicyCondition_And)(nil),
+		(*PolicyCondition_MfaAuthenticated)(nil),
+		(*PolicyCondition_ResourceAttribute)(nil),
+		(*PolicyCondition_ResourceMatchesGroupAttribute)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_authnz_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   37,
+			NumEnums:      5,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,18 +29,65 @@ type AuthNZClient interface {
 	CreateGroupsForSubjects(ctx context.Context, in *CreateGroupForSubjectsRequest, opts ...grpc.CallOption) (*CreateGroupForSubjectsResponse, error)
 	FindSubjectsByUserID(ctx context.Context, in *SubjectsByUserIDRequest, opts ...grpc.CallOption) (*SubjectsByUserIDResponse, error)
 	DeleteSubject(ctx context.Context, in *SubjectIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	CreateGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
 	GetGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*GroupResponse, error)
 	GetGroupsBySubjectID(ctx context.Context, in *SubjectIDRequest, opts ...grpc.CallOption) (*GroupsResponse, error)
+	// returns the groups having all of the attributes themselves
+	ListGroupsByAttributes(ctx context.Context, in *ListGroupsByAttributesRequest, opts ...grpc.CallOption) (*GroupsResponse, error)
 	UpdateGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
 	DeleteGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error)
+	// changes the memberships in one transaction, subjects that can not be changed are reported in their results
+	AddSubjectsToGroup(ctx context.Context, in *AddSubjectsToGroupRequest, opts ...grpc.CallOption) (*GroupMembershipResponse, error)
+	RemoveSubjectsFromGroup(ctx context.Context, in *RemoveSubjectsFromGroupRequest, opts ...grpc.CallOption) (*GroupMembershipResponse, error)
 	DuplicateGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	AttachParentGroup(ctx context.Context, in *GroupParentRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	DetachParentGroup(ctx context.Context, in *GroupParentRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	// returns the group with the attributes it inherits merged into its own
+	GetEffectiveGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	AssignGroupRole(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	UnassignGroupRole(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	CreateRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*Role, error)
+	GetRole(ctx context.Context, in *RoleByIDRequest, opts ...grpc.CallOption) (*Role, error)
+	UpdateRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*Role, error)
+	DeleteRole(ctx context.Context, in *RoleByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// permits when any role of the subject's groups, including inherited ones, grants the permission
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// permits when the subject has the relation on the object, directly or through the namespace's rewrites
+	CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error)
+	ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*RelationTree, error)
 	CreatePolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*Policy, error)
 	GetPolicy(ctx context.Context, in *PolicyByIDRequest, opts ...grpc.CallOption) (*Policy, error)
 	UpdatePolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*Policy, error)
 	DeletePolicy(ctx context.Context, in *PolicyByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	CreateContract(ctx context.Context, in *ContractRequest, opts ...grpc.CallOption) (*ContractResponse, error)
+	UpdateContractWindow(ctx context.Context, in *ContractWindowRequest, opts ...grpc.CallOption) (*ContractResponse, error)
+	// grants an emergency contract at once, skipping access request approval
+	BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*ContractResponse, error)
 	DeleteContract(ctx context.Context, in *DeleteContractRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CreateSoDRule(ctx context.Context, in *SoDRuleRequest, opts ...grpc.CallOption) (*SoDRule, error)
+	GetSoDRule(ctx context.Context, in *SoDRuleByIDRequest, opts ...grpc.CallOption) (*SoDRule, error)
+	UpdateSoDRule(ctx context.Context, in *SoDRuleRequest, opts ...grpc.CallOption) (*SoDRule, error)
+	DeleteSoDRule(ctx context.Context, in *SoDRuleByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*SoDRulesResponse, error)
+	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	GetAccessRequest(ctx context.Context, in *AccessRequestByIDRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	CancelAccessRequest(ctx context.Context, in *AccessRequestByIDRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, in *AccessDecisionRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, in *AccessDecisionRequest, opts ...grpc.CallOption) (*AccessRequest, error)
+	// lists the pending requests the approver may decide
+	ListPendingAccessRequests(ctx context.Context, in *ListPendingAccessRequestsRequest, opts ...grpc.CallOption) (*AccessRequestsResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*MFASessionResponse, error)
+	RedeemRecoveryCode(ctx context.Context, in *RecoveryCodeRequest, opts ...grpc.CallOption) (*MFASessionResponse, error)
+	DeleteTOTP(ctx context.Context, in *DeleteTOTPRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	FederatedLogin(ctx context.Context, in *FederatedLoginRequest, opts ...grpc.CallOption) (*FederatedLoginResponse, error)
+	LinkFederatedIdentity(ctx context.Context, in *LinkFederatedIdentityRequest, opts ...grpc.CallOption) (*FederatedIdentity, error)
+	UnlinkFederatedIdentity(ctx context.Context, in *FederatedIdentity, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListFederatedIdentities(ctx context.Context, in *SubjectIDRequest, opts ...grpc.CallOption) (*FederatedIdentitiesResponse, error)
 }
 
 type authNZClient struct {
@@ -114,6 +161,15 @@ func (c *authNZClient) DeleteSubject(ctx context.Context, in *SubjectIDRequest,
 	return out, nil
 }
 
+func (c *authNZClient) SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/setPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authNZClient) CreateGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
 	out := new(GroupResponse)
 	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/createGroup", in, out, opts...)
@@ -141,6 +197,15 @@ func (c *authNZClient) GetGroupsBySubjectID(ctx context.Context, in *SubjectIDRe
 	return out, nil
 }
 
+func (c *authNZClient) ListGroupsByAttributes(ctx context.Context, in *ListGroupsByAttributesRequest, opts ...grpc.CallOption) (*GroupsResponse, error) {
+	out := new(GroupsResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/listGroupsByAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authNZClient) UpdateGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
 	out := new(GroupResponse)
 	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/updateGroup", in, out, opts...)
@@ -159,6 +224,33 @@ func (c *authNZClient) DeleteGroup(ctx context.Context, in *GroupByIDRequest, op
 	return out, nil
 }
 
+func (c *authNZClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersResponse, error) {
+	out := new(GroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/listGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) AddSubjectsToGroup(ctx context.Context, in *AddSubjectsToGroupRequest, opts ...grpc.CallOption) (*GroupMembershipResponse, error) {
+	out := new(GroupMembershipResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/addSubjectsToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) RemoveSubjectsFromGroup(ctx context.Context, in *RemoveSubjectsFromGroupRequest, opts ...grpc.CallOption) (*GroupMembershipResponse, error) {
+	out := new(GroupMembershipResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/removeSubjectsFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authNZClient) DuplicateGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
 	out := new(GroupResponse)
 	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/duplicateGroup", in, out, opts...)
@@ -168,6 +260,123 @@ func (c *authNZClient) DuplicateGroup(ctx context.Context, in *GroupByIDRequest,
 	return out, nil
 }
 
+func (c *authNZClient) AttachParentGroup(ctx context.Context, in *GroupParentRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/attachParentGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) DetachParentGroup(ctx context.Context, in *GroupParentRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/detachParentGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) GetEffectiveGroup(ctx context.Context, in *GroupByIDRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/getEffectiveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) AssignGroupRole(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/assignGroupRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) UnassignGroupRole(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/unassignGroupRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) CreateRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/createRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) GetRole(ctx context.Context, in *RoleByIDRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/getRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) UpdateRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/updateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) DeleteRole(ctx context.Context, in *RoleByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/deleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/checkPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/writeRelationTuples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error) {
+	out := new(CheckRelationResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/checkRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*RelationTree, error) {
+	out := new(RelationTree)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/expandRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authNZClient) CreatePolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*Policy, error) {
 	out := new(Policy)
 	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/createPolicy", in, out, opts...)
@@ -213,6 +422,24 @@ func (c *authNZClient) CreateContract(ctx context.Context, in *ContractRequest,
 	return out, nil
 }
 
+func (c *authNZClient) UpdateContractWindow(ctx context.Context, in *ContractWindowRequest, opts ...grpc.CallOption) (*ContractResponse, error) {
+	out := new(ContractResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/updateContractWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*ContractResponse, error) {
+	out := new(ContractResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/breakGlass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authNZClient) DeleteContract(ctx context.Context, in *DeleteContractRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/deleteContract", in, out, opts...)
@@ -222,6 +449,186 @@ func (c *authNZClient) DeleteContract(ctx context.Context, in *DeleteContractReq
 	return out, nil
 }
 
+func (c *authNZClient) CreateSoDRule(ctx context.Context, in *SoDRuleRequest, opts ...grpc.CallOption) (*SoDRule, error) {
+	out := new(SoDRule)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/createSoDRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) GetSoDRule(ctx context.Context, in *SoDRuleByIDRequest, opts ...grpc.CallOption) (*SoDRule, error) {
+	out := new(SoDRule)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/getSoDRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) UpdateSoDRule(ctx context.Context, in *SoDRuleRequest, opts ...grpc.CallOption) (*SoDRule, error) {
+	out := new(SoDRule)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/updateSoDRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) DeleteSoDRule(ctx context.Context, in *SoDRuleByIDRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/deleteSoDRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*SoDRulesResponse, error) {
+	out := new(SoDRulesResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/listSoDRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/requestAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) GetAccessRequest(ctx context.Context, in *AccessRequestByIDRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/getAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) CancelAccessRequest(ctx context.Context, in *AccessRequestByIDRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/cancelAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ApproveAccessRequest(ctx context.Context, in *AccessDecisionRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/approveAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) DenyAccessRequest(ctx context.Context, in *AccessDecisionRequest, opts ...grpc.CallOption) (*AccessRequest, error) {
+	out := new(AccessRequest)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/denyAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ListPendingAccessRequests(ctx context.Context, in *ListPendingAccessRequestsRequest, opts ...grpc.CallOption) (*AccessRequestsResponse, error) {
+	out := new(AccessRequestsResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/listPendingAccessRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/enrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ConfirmTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/confirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*MFASessionResponse, error) {
+	out := new(MFASessionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/verifyTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) RedeemRecoveryCode(ctx context.Context, in *RecoveryCodeRequest, opts ...grpc.CallOption) (*MFASessionResponse, error) {
+	out := new(MFASessionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/redeemRecoveryCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) DeleteTOTP(ctx context.Context, in *DeleteTOTPRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/deleteTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) FederatedLogin(ctx context.Context, in *FederatedLoginRequest, opts ...grpc.CallOption) (*FederatedLoginResponse, error) {
+	out := new(FederatedLoginResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/federatedLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) LinkFederatedIdentity(ctx context.Context, in *LinkFederatedIdentityRequest, opts ...grpc.CallOption) (*FederatedIdentity, error) {
+	out := new(FederatedIdentity)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/linkFederatedIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) UnlinkFederatedIdentity(ctx context.Context, in *FederatedIdentity, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/unlinkFederatedIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authNZClient) ListFederatedIdentities(ctx context.Context, in *SubjectIDRequest, opts ...grpc.CallOption) (*FederatedIdentitiesResponse, error) {
+	out := new(FederatedIdentitiesResponse)
+	err := c.cc.Invoke(ctx, "/com.github.dlshle.authnz.AuthNZ/listFederatedIdentities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthNZServer is the server API for AuthNZ service.
 // All implementations must embed UnimplementedAuthNZServer
 // for forward compatibility
@@ -233,18 +640,65 @@ type AuthNZServer interface {
 	CreateGroupsForSubjects(context.Context, *CreateGroupForSubjectsRequest) (*CreateGroupForSubjectsResponse, error)
 	FindSubjectsByUserID(context.Context, *SubjectsByUserIDRequest) (*SubjectsByUserIDResponse, error)
 	DeleteSubject(context.Context, *SubjectIDRequest) (*EmptyResponse, error)
+	SetPassword(context.Context, *SetPasswordRequest) (*EmptyResponse, error)
 	CreateGroup(context.Context, *GroupRequest) (*GroupResponse, error)
 	GetGroup(context.Context, *GroupByIDRequest) (*GroupResponse, error)
 	GetGroupsBySubjectID(context.Context, *SubjectIDRequest) (*GroupsResponse, error)
+	// returns the groups having all of the attributes themselves
+	ListGroupsByAttributes(context.Context, *ListGroupsByAttributesRequest) (*GroupsResponse, error)
 	UpdateGroup(context.Context, *GroupRequest) (*GroupResponse, error)
 	DeleteGroup(context.Context, *GroupByIDRequest) (*EmptyResponse, error)
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*GroupMembersResponse, error)
+	// changes the memberships in one transaction, subjects that can not be changed are reported in their results
+	AddSubjectsToGroup(context.Context, *AddSubjectsToGroupRequest) (*GroupMembershipResponse, error)
+	RemoveSubjectsFromGroup(context.Context, *RemoveSubjectsFromGroupRequest) (*GroupMembershipResponse, error)
 	DuplicateGroup(context.Context, *GroupByIDRequest) (*GroupResponse, error)
+	AttachParentGroup(context.Context, *GroupParentRequest) (*GroupResponse, error)
+	DetachParentGroup(context.Context, *GroupParentRequest) (*GroupResponse, error)
+	// returns the group with the attributes it inherits merged into its own
+	GetEffectiveGroup(context.Context, *GroupByIDRequest) (*GroupResponse, error)
+	AssignGroupRole(context.Context, *GroupRoleRequest) (*GroupResponse, error)
+	UnassignGroupRole(context.Context, *GroupRoleRequest) (*GroupResponse, error)
+	CreateRole(context.Context, *RoleRequest) (*Role, error)
+	GetRole(context.Context, *RoleByIDRequest) (*Role, error)
+	UpdateRole(context.Context, *RoleRequest) (*Role, error)
+	DeleteRole(context.Context, *RoleByIDRequest) (*EmptyResponse, error)
+	// permits when any role of the subject's groups, including inherited ones, grants the permission
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*EmptyResponse, error)
+	// permits when the subject has the relation on the object, directly or through the namespace's rewrites
+	CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error)
+	ExpandRelation(context.Context, *ExpandRelationRequest) (*RelationTree, error)
 	CreatePolicy(context.Context, *PolicyRequest) (*Policy, error)
 	GetPolicy(context.Context, *PolicyByIDRequest) (*Policy, error)
 	UpdatePolicy(context.Context, *PolicyRequest) (*Policy, error)
 	DeletePolicy(context.Context, *PolicyByIDRequest) (*EmptyResponse, error)
 	CreateContract(context.Context, *ContractRequest) (*ContractResponse, error)
+	UpdateContractWindow(context.Context, *ContractWindowRequest) (*ContractResponse, error)
+	// grants an emergency contract at once, skipping access request approval
+	BreakGlass(context.Context, *BreakGlassRequest) (*ContractResponse, error)
 	DeleteContract(context.Context, *DeleteContractRequest) (*EmptyResponse, error)
+	CreateSoDRule(context.Context, *SoDRuleRequest) (*SoDRule, error)
+	GetSoDRule(context.Context, *SoDRuleByIDRequest) (*SoDRule, error)
+	UpdateSoDRule(context.Context, *SoDRuleRequest) (*SoDRule, error)
+	DeleteSoDRule(context.Context, *SoDRuleByIDRequest) (*EmptyResponse, error)
+	ListSoDRules(context.Context, *ListSoDRulesRequest) (*SoDRulesResponse, error)
+	RequestAccess(context.Context, *RequestAccessRequest) (*AccessRequest, error)
+	GetAccessRequest(context.Context, *AccessRequestByIDRequest) (*AccessRequest, error)
+	CancelAccessRequest(context.Context, *AccessRequestByIDRequest) (*AccessRequest, error)
+	ApproveAccessRequest(context.Context, *AccessDecisionRequest) (*AccessRequest, error)
+	DenyAccessRequest(context.Context, *AccessDecisionRequest) (*AccessRequest, error)
+	// lists the pending requests the approver may decide
+	ListPendingAccessRequests(context.Context, *ListPendingAccessRequestsRequest) (*AccessRequestsResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *VerifyTOTPRequest) (*EmptyResponse, error)
+	VerifyTOTP(context.Context, *VerifyTOTPRequest) (*MFASessionResponse, error)
+	RedeemRecoveryCode(context.Context, *RecoveryCodeRequest) (*MFASessionResponse, error)
+	DeleteTOTP(context.Context, *DeleteTOTPRequest) (*EmptyResponse, error)
+	FederatedLogin(context.Context, *FederatedLoginRequest) (*FederatedLoginResponse, error)
+	LinkFederatedIdentity(context.Context, *LinkFederatedIdentityRequest) (*FederatedIdentity, error)
+	UnlinkFederatedIdentity(context.Context, *FederatedIdentity) (*EmptyResponse, error)
+	ListFederatedIdentities(context.Context, *SubjectIDRequest) (*FederatedIdentitiesResponse, error)
 	mustEmbedUnimplementedAuthNZServer()
 }
 
@@ -273,6 +727,9 @@ func (UnimplementedAuthNZServer) FindSubjectsByUserID(context.Context, *Subjects
 func (UnimplementedAuthNZServer) DeleteSubject(context.Context, *SubjectIDRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubject not implemented")
 }
+func (UnimplementedAuthNZServer) SetPassword(context.Context, *SetPasswordRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPassword not implemented")
+}
 func (UnimplementedAuthNZServer) CreateGroup(context.Context, *GroupRequest) (*GroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
@@ -282,15 +739,66 @@ func (UnimplementedAuthNZServer) GetGroup(context.Context, *GroupByIDRequest) (*
 func (UnimplementedAuthNZServer) GetGroupsBySubjectID(context.Context, *SubjectIDRequest) (*GroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsBySubjectID not implemented")
 }
+func (UnimplementedAuthNZServer) ListGroupsByAttributes(context.Context, *ListGroupsByAttributesRequest) (*GroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupsByAttributes not implemented")
+}
 func (UnimplementedAuthNZServer) UpdateGroup(context.Context, *GroupRequest) (*GroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
 func (UnimplementedAuthNZServer) DeleteGroup(context.Context, *GroupByIDRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
+func (UnimplementedAuthNZServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*GroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedAuthNZServer) AddSubjectsToGroup(context.Context, *AddSubjectsToGroupRequest) (*GroupMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubjectsToGroup not implemented")
+}
+func (UnimplementedAuthNZServer) RemoveSubjectsFromGroup(context.Context, *RemoveSubjectsFromGroupRequest) (*GroupMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubjectsFromGroup not implemented")
+}
 func (UnimplementedAuthNZServer) DuplicateGroup(context.Context, *GroupByIDRequest) (*GroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DuplicateGroup not implemented")
 }
+func (UnimplementedAuthNZServer) AttachParentGroup(context.Context, *GroupParentRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachParentGroup not implemented")
+}
+func (UnimplementedAuthNZServer) DetachParentGroup(context.Context, *GroupParentRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachParentGroup not implemented")
+}
+func (UnimplementedAuthNZServer) GetEffectiveGroup(context.Context, *GroupByIDRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveGroup not implemented")
+}
+func (UnimplementedAuthNZServer) AssignGroupRole(context.Context, *GroupRoleRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignGroupRole not implemented")
+}
+func (UnimplementedAuthNZServer) UnassignGroupRole(context.Context, *GroupRoleRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignGroupRole not implemented")
+}
+func (UnimplementedAuthNZServer) CreateRole(context.Context, *RoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedAuthNZServer) GetRole(context.Context, *RoleByIDRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedAuthNZServer) UpdateRole(context.Context, *RoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAuthNZServer) DeleteRole(context.Context, *RoleByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedAuthNZServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedAuthNZServer) WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRelationTuples not implemented")
+}
+func (UnimplementedAuthNZServer) CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRelation not implemented")
+}
+func (UnimplementedAuthNZServer) ExpandRelation(context.Context, *ExpandRelationRequest) (*RelationTree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandRelation not implemented")
+}
 func (UnimplementedAuthNZServer) CreatePolicy(context.Context, *PolicyRequest) (*Policy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
 }
@@ -303,363 +811,1149 @@ func (UnimplementedAuthNZServer) UpdatePolicy(context.Context, *PolicyRequest) (
 func (UnimplementedAuthNZServer) DeletePolicy(context.Context, *PolicyByIDRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
 }
-func (UnimplementedAuthNZServer) CreateContract(context.Context, *ContractRequest) (*ContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+func (UnimplementedAuthNZServer) CreateContract(context.Context, *ContractRequest) (*ContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (UnimplementedAuthNZServer) UpdateContractWindow(context.Context, *ContractWindowRequest) (*ContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractWindow not implemented")
+}
+func (UnimplementedAuthNZServer) BreakGlass(context.Context, *BreakGlassRequest) (*ContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlass not implemented")
+}
+func (UnimplementedAuthNZServer) DeleteContract(context.Context, *DeleteContractRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContract not implemented")
+}
+func (UnimplementedAuthNZServer) CreateSoDRule(context.Context, *SoDRuleRequest) (*SoDRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSoDRule not implemented")
+}
+func (UnimplementedAuthNZServer) GetSoDRule(context.Context, *SoDRuleByIDRequest) (*SoDRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSoDRule not implemented")
+}
+func (UnimplementedAuthNZServer) UpdateSoDRule(context.Context, *SoDRuleRequest) (*SoDRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSoDRule not implemented")
+}
+func (UnimplementedAuthNZServer) DeleteSoDRule(context.Context, *SoDRuleByIDRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoDRule not implemented")
+}
+func (UnimplementedAuthNZServer) ListSoDRules(context.Context, *ListSoDRulesRequest) (*SoDRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDRules not implemented")
+}
+func (UnimplementedAuthNZServer) RequestAccess(context.Context, *RequestAccessRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedAuthNZServer) GetAccessRequest(context.Context, *AccessRequestByIDRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedAuthNZServer) CancelAccessRequest(context.Context, *AccessRequestByIDRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccessRequest not implemented")
+}
+func (UnimplementedAuthNZServer) ApproveAccessRequest(context.Context, *AccessDecisionRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedAuthNZServer) DenyAccessRequest(context.Context, *AccessDecisionRequest) (*AccessRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
+func (UnimplementedAuthNZServer) ListPendingAccessRequests(context.Context, *ListPendingAccessRequestsRequest) (*AccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingAccessRequests not implemented")
+}
+func (UnimplementedAuthNZServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAuthNZServer) ConfirmTOTP(context.Context, *VerifyTOTPRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAuthNZServer) VerifyTOTP(context.Context, *VerifyTOTPRequest) (*MFASessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTP not implemented")
+}
+func (UnimplementedAuthNZServer) RedeemRecoveryCode(context.Context, *RecoveryCodeRequest) (*MFASessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemRecoveryCode not implemented")
+}
+func (UnimplementedAuthNZServer) DeleteTOTP(context.Context, *DeleteTOTPRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTOTP not implemented")
+}
+func (UnimplementedAuthNZServer) FederatedLogin(context.Context, *FederatedLoginRequest) (*FederatedLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederatedLogin not implemented")
+}
+func (UnimplementedAuthNZServer) LinkFederatedIdentity(context.Context, *LinkFederatedIdentityRequest) (*FederatedIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkFederatedIdentity not implemented")
+}
+func (UnimplementedAuthNZServer) UnlinkFederatedIdentity(context.Context, *FederatedIdentity) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkFederatedIdentity not implemented")
+}
+func (UnimplementedAuthNZServer) ListFederatedIdentities(context.Context, *SubjectIDRequest) (*FederatedIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederatedIdentities not implemented")
+}
+func (UnimplementedAuthNZServer) mustEmbedUnimplementedAuthNZServer() {}
+
+// UnsafeAuthNZServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthNZServer will
+// result in compilation errors.
+type UnsafeAuthNZServer interface {
+	mustEmbedUnimplementedAuthNZServer()
+}
+
+func RegisterAuthNZServer(s grpc.ServiceRegistrar, srv AuthNZServer) {
+	s.RegisterService(&AuthNZ_ServiceDesc, srv)
+}
+
+func _AuthNZ_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_AddSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).AddSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/addSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).AddSubject(ctx, req.(*AddSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetSubject(ctx, req.(*SubjectIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_AddSubjectWithAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubjectWithAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).AddSubjectWithAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/addSubjectWithAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).AddSubjectWithAttributes(ctx, req.(*AddSubjectWithAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CreateGroupsForSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupForSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreateGroupsForSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createGroupsForSubjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreateGroupsForSubjects(ctx, req.(*CreateGroupForSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_FindSubjectsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectsByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).FindSubjectsByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/findSubjectsByUserID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).FindSubjectsByUserID(ctx, req.(*SubjectsByUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DeleteSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DeleteSubject(ctx, req.(*SubjectIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_SetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).SetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/setPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).SetPassword(ctx, req.(*SetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreateGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetGroup(ctx, req.(*GroupByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetGroupsBySubjectID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetGroupsBySubjectID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getGroupsBySubjectID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetGroupsBySubjectID(ctx, req.(*SubjectIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_ListGroupsByAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsByAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).ListGroupsByAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/listGroupsByAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).ListGroupsByAttributes(ctx, req.(*ListGroupsByAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).UpdateGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DeleteGroup(ctx, req.(*GroupByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/listGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_AddSubjectsToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubjectsToGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).AddSubjectsToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/addSubjectsToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).AddSubjectsToGroup(ctx, req.(*AddSubjectsToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_RemoveSubjectsFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubjectsFromGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).RemoveSubjectsFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/removeSubjectsFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).RemoveSubjectsFromGroup(ctx, req.(*RemoveSubjectsFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DuplicateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DuplicateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/duplicateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DuplicateGroup(ctx, req.(*GroupByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_AttachParentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).AttachParentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/attachParentGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).AttachParentGroup(ctx, req.(*GroupParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DetachParentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DetachParentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/detachParentGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DetachParentGroup(ctx, req.(*GroupParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetEffectiveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetEffectiveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getEffectiveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetEffectiveGroup(ctx, req.(*GroupByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_AssignGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).AssignGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/assignGroupRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).AssignGroupRole(ctx, req.(*GroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_UnassignGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).UnassignGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/unassignGroupRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).UnassignGroupRole(ctx, req.(*GroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreateRole(ctx, req.(*RoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetRole(ctx, req.(*RoleByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).UpdateRole(ctx, req.(*RoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DeleteRole(ctx, req.(*RoleByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/checkPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_WriteRelationTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRelationTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).WriteRelationTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/writeRelationTuples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).WriteRelationTuples(ctx, req.(*WriteRelationTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CheckRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CheckRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/checkRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CheckRelation(ctx, req.(*CheckRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_ExpandRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).ExpandRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/expandRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).ExpandRelation(ctx, req.(*ExpandRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreatePolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).GetPolicy(ctx, req.(*PolicyByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).UpdatePolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deletePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DeletePolicy(ctx, req.(*PolicyByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreateContract(ctx, req.(*ContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthNZ_UpdateContractWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).UpdateContractWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updateContractWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).UpdateContractWindow(ctx, req.(*ContractWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthNZServer) DeleteContract(context.Context, *DeleteContractRequest) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContract not implemented")
+
+func _AuthNZ_BreakGlass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakGlassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).BreakGlass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/breakGlass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).BreakGlass(ctx, req.(*BreakGlassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthNZServer) mustEmbedUnimplementedAuthNZServer() {}
 
-// UnsafeAuthNZServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AuthNZServer will
-// result in compilation errors.
-type UnsafeAuthNZServer interface {
-	mustEmbedUnimplementedAuthNZServer()
+func _AuthNZ_DeleteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).DeleteContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).DeleteContract(ctx, req.(*DeleteContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAuthNZServer(s grpc.ServiceRegistrar, srv AuthNZServer) {
-	s.RegisterService(&AuthNZ_ServiceDesc, srv)
+func _AuthNZ_CreateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoDRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthNZServer).CreateSoDRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createSoDRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthNZServer).CreateSoDRule(ctx, req.(*SoDRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizeRequest)
+func _AuthNZ_GetSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoDRuleByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).Authorize(ctx, in)
+		return srv.(AuthNZServer).GetSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/authorize",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getSoDRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).Authorize(ctx, req.(*AuthorizeRequest))
+		return srv.(AuthNZServer).GetSoDRule(ctx, req.(*SoDRuleByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_AddSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSubjectRequest)
+func _AuthNZ_UpdateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoDRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).AddSubject(ctx, in)
+		return srv.(AuthNZServer).UpdateSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/addSubject",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updateSoDRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).AddSubject(ctx, req.(*AddSubjectRequest))
+		return srv.(AuthNZServer).UpdateSoDRule(ctx, req.(*SoDRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubjectIDRequest)
+func _AuthNZ_DeleteSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoDRuleByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).GetSubject(ctx, in)
+		return srv.(AuthNZServer).DeleteSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getSubject",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteSoDRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).GetSubject(ctx, req.(*SubjectIDRequest))
+		return srv.(AuthNZServer).DeleteSoDRule(ctx, req.(*SoDRuleByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_AddSubjectWithAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSubjectWithAttributesRequest)
+func _AuthNZ_ListSoDRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).AddSubjectWithAttributes(ctx, in)
+		return srv.(AuthNZServer).ListSoDRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/addSubjectWithAttributes",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/listSoDRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).AddSubjectWithAttributes(ctx, req.(*AddSubjectWithAttributesRequest))
+		return srv.(AuthNZServer).ListSoDRules(ctx, req.(*ListSoDRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_CreateGroupsForSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupForSubjectsRequest)
+func _AuthNZ_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).CreateGroupsForSubjects(ctx, in)
+		return srv.(AuthNZServer).RequestAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createGroupsForSubjects",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/requestAccess",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).CreateGroupsForSubjects(ctx, req.(*CreateGroupForSubjectsRequest))
+		return srv.(AuthNZServer).RequestAccess(ctx, req.(*RequestAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_FindSubjectsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubjectsByUserIDRequest)
+func _AuthNZ_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessRequestByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).FindSubjectsByUserID(ctx, in)
+		return srv.(AuthNZServer).GetAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/findSubjectsByUserID",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).FindSubjectsByUserID(ctx, req.(*SubjectsByUserIDRequest))
+		return srv.(AuthNZServer).GetAccessRequest(ctx, req.(*AccessRequestByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubjectIDRequest)
+func _AuthNZ_CancelAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessRequestByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).DeleteSubject(ctx, in)
+		return srv.(AuthNZServer).CancelAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteSubject",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/cancelAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).DeleteSubject(ctx, req.(*SubjectIDRequest))
+		return srv.(AuthNZServer).CancelAccessRequest(ctx, req.(*AccessRequestByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
+func _AuthNZ_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).CreateGroup(ctx, in)
+		return srv.(AuthNZServer).ApproveAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createGroup",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/approveAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).CreateGroup(ctx, req.(*GroupRequest))
+		return srv.(AuthNZServer).ApproveAccessRequest(ctx, req.(*AccessDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupByIDRequest)
+func _AuthNZ_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).GetGroup(ctx, in)
+		return srv.(AuthNZServer).DenyAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getGroup",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/denyAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).GetGroup(ctx, req.(*GroupByIDRequest))
+		return srv.(AuthNZServer).DenyAccessRequest(ctx, req.(*AccessDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_GetGroupsBySubjectID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubjectIDRequest)
+func _AuthNZ_ListPendingAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingAccessRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).GetGroupsBySubjectID(ctx, in)
+		return srv.(AuthNZServer).ListPendingAccessRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getGroupsBySubjectID",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/listPendingAccessRequests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).GetGroupsBySubjectID(ctx, req.(*SubjectIDRequest))
+		return srv.(AuthNZServer).ListPendingAccessRequests(ctx, req.(*ListPendingAccessRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
+func _AuthNZ_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).UpdateGroup(ctx, in)
+		return srv.(AuthNZServer).EnrollTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updateGroup",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/enrollTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).UpdateGroup(ctx, req.(*GroupRequest))
+		return srv.(AuthNZServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupByIDRequest)
+func _AuthNZ_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).DeleteGroup(ctx, in)
+		return srv.(AuthNZServer).ConfirmTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteGroup",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/confirmTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).DeleteGroup(ctx, req.(*GroupByIDRequest))
+		return srv.(AuthNZServer).ConfirmTOTP(ctx, req.(*VerifyTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_DuplicateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupByIDRequest)
+func _AuthNZ_VerifyTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).DuplicateGroup(ctx, in)
+		return srv.(AuthNZServer).VerifyTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/duplicateGroup",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/verifyTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).DuplicateGroup(ctx, req.(*GroupByIDRequest))
+		return srv.(AuthNZServer).VerifyTOTP(ctx, req.(*VerifyTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _AuthNZ_RedeemRecoveryCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).CreatePolicy(ctx, in)
+		return srv.(AuthNZServer).RedeemRecoveryCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createPolicy",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/redeemRecoveryCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).CreatePolicy(ctx, req.(*PolicyRequest))
+		return srv.(AuthNZServer).RedeemRecoveryCode(ctx, req.(*RecoveryCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyByIDRequest)
+func _AuthNZ_DeleteTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).GetPolicy(ctx, in)
+		return srv.(AuthNZServer).DeleteTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/getPolicy",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).GetPolicy(ctx, req.(*PolicyByIDRequest))
+		return srv.(AuthNZServer).DeleteTOTP(ctx, req.(*DeleteTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _AuthNZ_FederatedLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederatedLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).UpdatePolicy(ctx, in)
+		return srv.(AuthNZServer).FederatedLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/updatePolicy",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/federatedLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).UpdatePolicy(ctx, req.(*PolicyRequest))
+		return srv.(AuthNZServer).FederatedLogin(ctx, req.(*FederatedLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyByIDRequest)
+func _AuthNZ_LinkFederatedIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkFederatedIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).DeletePolicy(ctx, in)
+		return srv.(AuthNZServer).LinkFederatedIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deletePolicy",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/linkFederatedIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).DeletePolicy(ctx, req.(*PolicyByIDRequest))
+		return srv.(AuthNZServer).LinkFederatedIdentity(ctx, req.(*LinkFederatedIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractRequest)
+func _AuthNZ_UnlinkFederatedIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederatedIdentity)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).CreateContract(ctx, in)
+		return srv.(AuthNZServer).UnlinkFederatedIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/createContract",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/unlinkFederatedIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).CreateContract(ctx, req.(*ContractRequest))
+		return srv.(AuthNZServer).UnlinkFederatedIdentity(ctx, req.(*FederatedIdentity))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthNZ_DeleteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteContractRequest)
+func _AuthNZ_ListFederatedIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthNZServer).DeleteContract(ctx, in)
+		return srv.(AuthNZServer).ListFederatedIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/com.github.dlshle.authnz.AuthNZ/deleteContract",
+		FullMethod: "/com.github.dlshle.authnz.AuthNZ/listFederatedIdentities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthNZServer).DeleteContract(ctx, req.(*DeleteContractRequest))
+		return srv.(AuthNZServer).ListFederatedIdentities(ctx, req.(*SubjectIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -699,6 +1993,10 @@ var AuthNZ_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteSubject",
 			Handler:    _AuthNZ_DeleteSubject_Handler,
 		},
+		{
+			MethodName: "setPassword",
+			Handler:    _AuthNZ_SetPassword_Handler,
+		},
 		{
 			MethodName: "createGroup",
 			Handler:    _AuthNZ_CreateGroup_Handler,
@@ -711,6 +2009,10 @@ var AuthNZ_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getGroupsBySubjectID",
 			Handler:    _AuthNZ_GetGroupsBySubjectID_Handler,
 		},
+		{
+			MethodName: "listGroupsByAttributes",
+			Handler:    _AuthNZ_ListGroupsByAttributes_Handler,
+		},
 		{
 			MethodName: "updateGroup",
 			Handler:    _AuthNZ_UpdateGroup_Handler,
@@ -719,10 +2021,74 @@ var AuthNZ_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteGroup",
 			Handler:    _AuthNZ_DeleteGroup_Handler,
 		},
+		{
+			MethodName: "listGroupMembers",
+			Handler:    _AuthNZ_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "addSubjectsToGroup",
+			Handler:    _AuthNZ_AddSubjectsToGroup_Handler,
+		},
+		{
+			MethodName: "removeSubjectsFromGroup",
+			Handler:    _AuthNZ_RemoveSubjectsFromGroup_Handler,
+		},
 		{
 			MethodName: "duplicateGroup",
 			Handler:    _AuthNZ_DuplicateGroup_Handler,
 		},
+		{
+			MethodName: "attachParentGroup",
+			Handler:    _AuthNZ_AttachParentGroup_Handler,
+		},
+		{
+			MethodName: "detachParentGroup",
+			Handler:    _AuthNZ_DetachParentGroup_Handler,
+		},
+		{
+			MethodName: "getEffectiveGroup",
+			Handler:    _AuthNZ_GetEffectiveGroup_Handler,
+		},
+		{
+			MethodName: "assignGroupRole",
+			Handler:    _AuthNZ_AssignGroupRole_Handler,
+		},
+		{
+			MethodName: "unassignGroupRole",
+			Handler:    _AuthNZ_UnassignGroupRole_Handler,
+		},
+		{
+			MethodName: "createRole",
+			Handler:    _AuthNZ_CreateRole_Handler,
+		},
+		{
+			MethodName: "getRole",
+			Handler:    _AuthNZ_GetRole_Handler,
+		},
+		{
+			MethodName: "updateRole",
+			Handler:    _AuthNZ_UpdateRole_Handler,
+		},
+		{
+			MethodName: "deleteRole",
+			Handler:    _AuthNZ_DeleteRole_Handler,
+		},
+		{
+			MethodName: "checkPermission",
+			Handler:    _AuthNZ_CheckPermission_Handler,
+		},
+		{
+			MethodName: "writeRelationTuples",
+			Handler:    _AuthNZ_WriteRelationTuples_Handler,
+		},
+		{
+			MethodName: "checkRelation",
+			Handler:    _AuthNZ_CheckRelation_Handler,
+		},
+		{
+			MethodName: "expandRelation",
+			Handler:    _AuthNZ_ExpandRelation_Handler,
+		},
 		{
 			MethodName: "createPolicy",
 			Handler:    _AuthNZ_CreatePolicy_Handler,
@@ -743,10 +2109,98 @@ var AuthNZ_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "createContract",
 			Handler:    _AuthNZ_CreateContract_Handler,
 		},
+		{
+			MethodName: "updateContractWindow",
+			Handler:    _AuthNZ_UpdateContractWindow_Handler,
+		},
+		{
+			MethodName: "breakGlass",
+			Handler:    _AuthNZ_BreakGlass_Handler,
+		},
 		{
 			MethodName: "deleteContract",
 			Handler:    _AuthNZ_DeleteContract_Handler,
 		},
+		{
+			MethodName: "createSoDRule",
+			Handler:    _AuthNZ_CreateSoDRule_Handler,
+		},
+		{
+			MethodName: "getSoDRule",
+			Handler:    _AuthNZ_GetSoDRule_Handler,
+		},
+		{
+			MethodName: "updateSoDRule",
+			Handler:    _AuthNZ_UpdateSoDRule_Handler,
+		},
+		{
+			MethodName: "deleteSoDRule",
+			Handler:    _AuthNZ_DeleteSoDRule_Handler,
+		},
+		{
+			MethodName: "listSoDRules",
+			Handler:    _AuthNZ_ListSoDRules_Handler,
+		},
+		{
+			MethodName: "requestAccess",
+			Handler:    _AuthNZ_RequestAccess_Handler,
+		},
+		{
+			MethodName: "getAccessRequest",
+			Handler:    _AuthNZ_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "cancelAccessRequest",
+			Handler:    _AuthNZ_CancelAccessRequest_Handler,
+		},
+		{
+			MethodName: "approveAccessRequest",
+			Handler:    _AuthNZ_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "denyAccessRequest",
+			Handler:    _AuthNZ_DenyAccessRequest_Handler,
+		},
+		{
+			MethodName: "listPendingAccessRequests",
+			Handler:    _AuthNZ_ListPendingAccessRequests_Handler,
+		},
+		{
+			MethodName: "enrollTOTP",
+			Handler:    _AuthNZ_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "confirmTOTP",
+			Handler:    _AuthNZ_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "verifyTOTP",
+			Handler:    _AuthNZ_VerifyTOTP_Handler,
+		},
+		{
+			MethodName: "redeemRecoveryCode",
+			Handler:    _AuthNZ_RedeemRecoveryCode_Handler,
+		},
+		{
+			MethodName: "deleteTOTP",
+			Handler:    _AuthNZ_DeleteTOTP_Handler,
+		},
+		{
+			MethodName: "federatedLogin",
+			Handler:    _AuthNZ_FederatedLogin_Handler,
+		},
+		{
+			MethodName: "linkFederatedIdentity",
+			Handler:    _AuthNZ_LinkFederatedIdentity_Handler,
+		},
+		{
+			MethodName: "unlinkFederatedIdentity",
+			Handler:    _AuthNZ_UnlinkFederatedIdentity_Handler,
+		},
+		{
+			MethodName: "listFederatedIdentities",
+			Handler:    _AuthNZ_ListFederatedIdentities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/authnz.proto",